@@ -0,0 +1,8 @@
+data "xray_ignore_rules" "log4shell" {
+  vulnerability = "CVE-2021-44228"
+  expires_after = "2025-01-01T00:00:00Z"
+}
+
+output "log4shell_ignore_rules" {
+  value = [for rule in data.xray_ignore_rules.log4shell.ignore_rules : "${rule.id}: ${rule.notes}"]
+}
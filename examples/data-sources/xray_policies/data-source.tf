@@ -0,0 +1,21 @@
+data "xray_policies" "security" {
+  type       = "security"
+  name_regex = "^team-.*"
+}
+
+resource "xray_watch" "all-repos" {
+  name   = "all-repos-watch"
+  active = true
+
+  watch_resource {
+    type = "all-repos"
+  }
+
+  dynamic "assigned_policy" {
+    for_each = data.xray_policies.security.policies
+    content {
+      name = assigned_policy.value.name
+      type = assigned_policy.value.type
+    }
+  }
+}
@@ -0,0 +1,8 @@
+data "xray_policy" "my_policy" {
+  name        = "my-security-policy"
+  project_key = "myproj"
+}
+
+output "my_policy_rules" {
+  value = data.xray_policy.my_policy.rules
+}
@@ -0,0 +1,8 @@
+data "xray_reports" "nightly" {
+  name_prefix = "nightly-"
+  status      = "completed"
+}
+
+output "nightly_reports" {
+  value = { for report in data.xray_reports.nightly.reports : report.name => report.end_time }
+}
@@ -0,0 +1,9 @@
+data "xray_violations" "critical" {
+  watch        = "my-watch"
+  type         = "security"
+  min_severity = "Critical"
+}
+
+output "critical_violations" {
+  value = { for violation in data.xray_violations.critical.violations : violation.issue_id => violation.infected_components }
+}
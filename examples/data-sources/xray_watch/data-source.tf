@@ -0,0 +1,8 @@
+data "xray_watch" "my_watch" {
+  name        = "my-watch"
+  project_key = "myproj"
+}
+
+output "my_watch_policies" {
+  value = data.xray_watch.my_watch.assigned_policy
+}
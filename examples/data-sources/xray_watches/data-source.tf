@@ -0,0 +1,5 @@
+data "xray_watches" "all" {}
+
+output "inactive_watches" {
+  value = [for watch in data.xray_watches.all.watches : watch.name if !watch.active]
+}
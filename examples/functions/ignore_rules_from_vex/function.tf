@@ -0,0 +1,18 @@
+locals {
+  vex_ignore_rules = provider::xray::ignore_rules_from_vex(file("${path.module}/vex.json"))
+}
+
+resource "xray_ignore_rule" "vex" {
+  for_each = {
+    for rule in local.vex_ignore_rules : "${rule.cve}:${rule.component.name}:${coalesce(rule.component.version, "any")}" => rule
+  }
+
+  notes      = each.value.notes
+  cves       = [each.value.cve]
+  expires_in = "90d"
+
+  component {
+    name    = each.value.component.name
+    version = each.value.component.version
+  }
+}
@@ -0,0 +1,64 @@
+locals {
+  exported_policy = provider::xray::policy_from_json(file("${path.module}/policy.json"))
+}
+
+resource "xray_security_policy" "imported" {
+  name        = local.exported_policy.name
+  description = local.exported_policy.description
+  type        = local.exported_policy.type
+
+  dynamic "rule" {
+    for_each = local.exported_policy.rules
+
+    content {
+      name     = rule.value.name
+      priority = rule.value.priority
+
+      dynamic "criteria" {
+        for_each = rule.value.criteria
+
+        content {
+          min_severity          = criteria.value.min_severity
+          fix_version_dependant = criteria.value.fix_version_dependant
+          applicable_cves_only  = criteria.value.applicable_cves_only
+          malicious_package     = criteria.value.malicious_package
+          vulnerability_ids     = criteria.value.vulnerability_ids
+
+          dynamic "cvss_range" {
+            for_each = criteria.value.cvss_range
+
+            content {
+              from = cvss_range.value.from
+              to   = cvss_range.value.to
+            }
+          }
+        }
+      }
+
+      dynamic "actions" {
+        for_each = rule.value.actions
+
+        content {
+          webhooks                           = actions.value.webhooks
+          mails                              = actions.value.mails
+          block_release_bundle_distribution  = actions.value.block_release_bundle_distribution
+          block_release_bundle_promotion     = actions.value.block_release_bundle_promotion
+          fail_build                         = actions.value.fail_build
+          notify_deployer                    = actions.value.notify_deployer
+          notify_watch_recipients            = actions.value.notify_watch_recipients
+          create_ticket_enabled              = actions.value.create_ticket_enabled
+          build_failure_grace_period_in_days = actions.value.build_failure_grace_period_in_days
+
+          dynamic "block_download" {
+            for_each = actions.value.block_download
+
+            content {
+              unscanned = block_download.value.unscanned
+              active    = block_download.value.active
+            }
+          }
+        }
+      }
+    }
+  }
+}
@@ -0,0 +1,22 @@
+resource "xray_exposures_report" "report" {
+  name = "test-exposures-report"
+
+  resources {
+    repository {
+      name                  = "reponame"
+      include_path_patterns = ["pattern1", "pattern2"]
+      exclude_path_patterns = ["pattern3", "pattern4"]
+    }
+  }
+
+  filters {
+    category          = "secrets"
+    impacted_artifact = "impacted-artifact"
+    severities        = ["High", "Critical"]
+
+    scan_date {
+      start = "2020-06-29T12:22:16Z"
+      end   = "2020-07-29T12:22:16Z"
+    }
+  }
+}
@@ -71,4 +71,17 @@ resource "xray_ignore_rule" "ignore-rule-2590578" {
       categories = [ "secrets" , "applications" ]
       file_path  = ["/path/to/file"]
   }
-}
\ No newline at end of file
+}
+
+resource "xray_ignore_rule" "ignore-rule-2590579" {
+  notes     = "accepted risk, reviewed every month"
+  cves      = ["CVE-2021-44228"]
+  on_expiry = "recreate"
+  renew_for = "30d"
+}
+
+resource "xray_ignore_rule" "ignore-rule-2590580" {
+  notes      = "accepted risk for 30 days"
+  cves       = ["CVE-2021-44228"]
+  expires_in = "30d"
+}
@@ -0,0 +1,16 @@
+resource "xray_ignore_rules_set" "accepted-risks" {
+  rules = {
+    "CVE-2021-44228" = {
+      notes           = "Not exploitable, JNDI lookups are disabled"
+      expiration_date = "2026-12-31"
+      watches         = ["my-watch"]
+      components = [{
+        name    = "gav://org.apache.logging.log4j:log4j-core"
+        version = "2.14.1"
+      }]
+    }
+    "XRAY-123456" = {
+      notes = "Accepted risk"
+    }
+  }
+}
@@ -0,0 +1,72 @@
+resource "xray_policy" "security" {
+  name        = "test-security-policy"
+  description = "Security policy description"
+  type        = "security"
+
+  rule {
+    name = "rule-name-severity"
+
+    criteria {
+      min_severity = "High"
+    }
+
+    actions {
+      fail_build = true
+
+      block_download {
+        unscanned = true
+        active    = true
+      }
+    }
+  }
+}
+
+resource "xray_policy" "license" {
+  name        = "test-license-policy"
+  description = "License policy description"
+  type        = "license"
+
+  rule {
+    name = "rule-name-banned"
+
+    criteria {
+      banned_licenses = ["0BSD", "AAL"]
+    }
+
+    actions {
+      custom_severity = "High"
+
+      block_download {
+        unscanned = false
+        active    = false
+      }
+    }
+  }
+}
+
+# Move an existing policy resource of a single type, requires Terraform 1.8 or later.
+moved {
+  from = xray_operational_risk_policy.op_risk
+  to   = xray_policy.op_risk
+}
+
+resource "xray_policy" "op_risk" {
+  name        = "test-operational-risk-policy"
+  description = "Operational risk policy description"
+  type        = "operational_risk"
+
+  rule {
+    name = "rule-name-min-risk"
+
+    criteria {
+      op_risk_min_risk = "Medium"
+    }
+
+    actions {
+      block_download {
+        unscanned = false
+        active    = false
+      }
+    }
+  }
+}
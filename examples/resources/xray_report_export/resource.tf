@@ -0,0 +1,25 @@
+resource "xray_licenses_report" "report" {
+  name = "test-license-report"
+
+  resources {
+    repository {
+      name = "reponame"
+    }
+  }
+
+  filters {
+    unknown      = true
+    unrecognized = true
+  }
+}
+
+resource "xray_report_export" "licenses" {
+  report_id   = xray_licenses_report.report.id
+  format      = "csv"
+  file_name   = "licenses"
+  output_path = "${path.module}/reports/licenses.csv"
+}
+
+output "licenses_report_sha256" {
+  value = xray_report_export.licenses.sha256
+}
@@ -98,4 +98,38 @@ resource "xray_security_policy" "malicious_package" {
       }
     }
   }
+}
+
+resource "xray_security_policy" "packages" {
+  name        = "test-security-policy-packages"
+  description = "Security policy description"
+  type        = "security"
+  project_key = "testproj"
+
+  rule {
+    name = "rule-name-packages"
+
+    criteria {
+      packages {
+        type     = "Npm"
+        name     = "lodash"
+        versions = ["(,4.17.21)"]
+      }
+
+      packages {
+        type     = "Maven"
+        name     = "org.apache.logging.log4j:log4j-core"
+        versions = ["[2.0,2.17.1)"]
+      }
+    }
+
+    actions {
+      fail_build = true
+
+      block_download {
+        unscanned = false
+        active    = true
+      }
+    }
+  }
 }
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "xray_watch_historical_scan" "my-watch" {
+  watch_names = [xray_watch.my-watch.name]
+  start_date  = "2025-01-01T00:00:00Z"
+
+  # Changes of the watch are otherwise detected from Xray when planning, i.e.
+  # only scanned by the apply after the one changing the watch.
+  triggers = {
+    watch = sha1(jsonencode(xray_watch.my-watch))
+  }
+}
@@ -0,0 +1 @@
+terraform import xray_watch_policy_assignment.team-license central-watch:team-license-policy
@@ -0,0 +1,20 @@
+resource "xray_watch" "central" {
+  name                     = "central-watch"
+  active                   = true
+  ignore_external_policies = true
+
+  watch_resource {
+    type = "all-repos"
+  }
+
+  assigned_policy {
+    name = "baseline-security-policy"
+    type = "security"
+  }
+}
+
+resource "xray_watch_policy_assignment" "team-license" {
+  watch_name  = xray_watch.central.name
+  policy_name = "team-license-policy"
+  policy_type = "license"
+}
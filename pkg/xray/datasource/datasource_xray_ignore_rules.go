@@ -0,0 +1,340 @@
+package datasource
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+)
+
+// ignoreRulesPageSize is the number of ignore rules requested per page.
+const ignoreRulesPageSize = 100
+
+var _ datasource.DataSource = &IgnoreRulesDataSource{}
+
+func NewIgnoreRulesDataSource() datasource.DataSource {
+	return &IgnoreRulesDataSource{}
+}
+
+type IgnoreRulesDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type IgnoreRulesDataSourceModel struct {
+	Vulnerability        types.String                            `tfsdk:"vulnerability"`
+	License              types.String                            `tfsdk:"license"`
+	Policy               types.String                            `tfsdk:"policy"`
+	Watch                types.String                            `tfsdk:"watch"`
+	ComponentName        types.String                            `tfsdk:"component_name"`
+	ComponentVersion     types.String                            `tfsdk:"component_version"`
+	ArtifactName         types.String                            `tfsdk:"artifact_name"`
+	ArtifactVersion      types.String                            `tfsdk:"artifact_version"`
+	BuildName            types.String                            `tfsdk:"build_name"`
+	BuildVersion         types.String                            `tfsdk:"build_version"`
+	ReleaseBundleName    types.String                            `tfsdk:"release_bundle_name"`
+	ReleaseBundleVersion types.String                            `tfsdk:"release_bundle_version"`
+	DockerLayer          types.String                            `tfsdk:"docker_layer"`
+	ExpiresBefore        types.String                            `tfsdk:"expires_before"`
+	ExpiresAfter         types.String                            `tfsdk:"expires_after"`
+	ProjectKey           types.String                            `tfsdk:"project_key"`
+	IgnoreRules          []xray_resource.IgnoreRuleResourceModel `tfsdk:"ignore_rules"`
+}
+
+// queryParams returns the server side filters of the Get Ignore Rules API
+// which are set in the configuration.
+func (m IgnoreRulesDataSourceModel) queryParams() map[string]string {
+	filters := map[string]types.String{
+		"vulnerability":          m.Vulnerability,
+		"license":                m.License,
+		"policy":                 m.Policy,
+		"watch":                  m.Watch,
+		"component_name":         m.ComponentName,
+		"component_version":      m.ComponentVersion,
+		"artifact_name":          m.ArtifactName,
+		"artifact_version":       m.ArtifactVersion,
+		"build_name":             m.BuildName,
+		"build_version":          m.BuildVersion,
+		"release_bundle_name":    m.ReleaseBundleName,
+		"release_bundle_version": m.ReleaseBundleVersion,
+		"docker_layer":           m.DockerLayer,
+		"expires_before":         m.ExpiresBefore,
+		"expires_after":          m.ExpiresAfter,
+	}
+
+	params := map[string]string{}
+	for name, value := range filters {
+		if !value.IsNull() {
+			params[name] = value.ValueString()
+		}
+	}
+
+	return params
+}
+
+type IgnoreRulesAPIModel struct {
+	Data       []xray_resource.IgnoreRuleAPIModel `json:"data"`
+	TotalCount int64                              `json:"total_count"`
+}
+
+func (d *IgnoreRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ignore_rules"
+}
+
+func (d *IgnoreRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func stringFilterAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		Description: description,
+	}
+}
+
+func nameVersionSetAttribute(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+				"version": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+		Computed:    true,
+		Description: description,
+	}
+}
+
+var ignoreRuleSchemaAttributes = map[string]schema.Attribute{
+	"id": schema.StringAttribute{
+		Computed:    true,
+		Description: "ID of the ignore rule.",
+	},
+	"project_key": schema.StringAttribute{
+		Computed:    true,
+		Description: "Project key of the ignore rule.",
+	},
+	"notes": schema.StringAttribute{
+		Computed:    true,
+		Description: "Notes of the ignore rule.",
+	},
+	"expiration_date": schema.StringAttribute{
+		Computed:    true,
+		Description: "Expiration date of the ignore rule in YYYY-MM-DD format.",
+	},
+	"author": schema.StringAttribute{
+		Computed:    true,
+		Description: "User, who created the ignore rule.",
+	},
+	"created": schema.StringAttribute{
+		Computed:    true,
+		Description: "Time when the ignore rule was created.",
+	},
+	"is_expired": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether the ignore rule is expired.",
+	},
+	"vulnerabilities": schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "List of ignored vulnerabilities.",
+	},
+	"cves": schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "List of ignored CVEs.",
+	},
+	"licenses": schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "List of ignored licenses.",
+	},
+	"operational_risk": schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "Ignored operational risks.",
+	},
+	"policies": schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "List of policies the ignore rule applies to.",
+	},
+	"watches": schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "List of watches the ignore rule applies to.",
+	},
+	"docker_layers": schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "List of ignored Docker layer SHA256 hashes.",
+	},
+	"release_bundle":     nameVersionSetAttribute("List of ignored release bundles."),
+	"release_bundles_v2": nameVersionSetAttribute("List of ignored release bundles v2."),
+	"build":              nameVersionSetAttribute("List of ignored builds."),
+	"component":          nameVersionSetAttribute("List of ignored components."),
+	"artifact": schema.SetNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+				"version": schema.StringAttribute{
+					Computed: true,
+				},
+				"path": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+		Computed:    true,
+		Description: "List of ignored artifacts.",
+	},
+	"exposures": schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"scanners": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"categories": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"file_path": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Computed:    true,
+		Description: "Ignored exposures.",
+	},
+}
+
+func (d *IgnoreRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"vulnerability":          stringFilterAttribute("Only return ignore rules for this vulnerability or CVE, e.g. `CVE-2021-44228`."),
+			"license":                stringFilterAttribute("Only return ignore rules for this license."),
+			"policy":                 stringFilterAttribute("Only return ignore rules for this policy."),
+			"watch":                  stringFilterAttribute("Only return ignore rules for this watch."),
+			"component_name":         stringFilterAttribute("Only return ignore rules for this component."),
+			"component_version":      stringFilterAttribute("Only return ignore rules for this component version."),
+			"artifact_name":          stringFilterAttribute("Only return ignore rules for this artifact."),
+			"artifact_version":       stringFilterAttribute("Only return ignore rules for this artifact version."),
+			"build_name":             stringFilterAttribute("Only return ignore rules for this build."),
+			"build_version":          stringFilterAttribute("Only return ignore rules for this build version."),
+			"release_bundle_name":    stringFilterAttribute("Only return ignore rules for this release bundle."),
+			"release_bundle_version": stringFilterAttribute("Only return ignore rules for this release bundle version."),
+			"docker_layer":           stringFilterAttribute("Only return ignore rules for this Docker layer SHA256 hash."),
+			"expires_before": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					xray_resource.IsRFC3339Time(),
+				},
+				Description: "Only return ignore rules expiring before this time, in RFC3339 format, e.g. `2025-01-01T00:00:00Z`.",
+			},
+			"expires_after": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					xray_resource.IsRFC3339Time(),
+				},
+				Description: "Only return ignore rules expiring after this time, in RFC3339 format, e.g. `2025-01-01T00:00:00Z`.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "Only return ignore rules of this project. Must be 2 - 10 lowercase alphanumeric and hyphen characters.",
+			},
+			"ignore_rules": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ignoreRuleSchemaAttributes,
+				},
+				Computed:    true,
+				Description: "List of ignore rules matching the filters.",
+			},
+		},
+		MarkdownDescription: "Get a list of Xray ignore rules, optionally filtered by vulnerability, license, policy, watch, component, artifact, build, release bundle, Docker layer and expiration date. See JFrog [Get Ignore Rules API documentation](https://jfrog.com/help/r/xray-rest-apis/get-ignore-rules) for more details.",
+	}
+}
+
+func (d *IgnoreRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IgnoreRulesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ignoreRules []xray_resource.IgnoreRuleAPIModel
+	for pageNum := 1; ; pageNum++ {
+		request := d.ProviderData.Client.R()
+		if projectKey := data.ProjectKey.ValueString(); projectKey != "" {
+			request.SetQueryParam("projectKey", projectKey)
+		}
+
+		var page IgnoreRulesAPIModel
+		response, err := request.
+			SetQueryParams(data.queryParams()).
+			SetQueryParams(map[string]string{
+				"page_num":    strconv.Itoa(pageNum),
+				"num_of_rows": strconv.Itoa(ignoreRulesPageSize),
+				"order_by":    "id",
+				"direction":   "asc",
+			}).
+			SetResult(&page).
+			Get(xray_resource.IgnoreRulesEndpoint)
+
+		if err != nil {
+			unableToReadDataSourceError(resp, err.Error())
+			return
+		}
+
+		if response.IsError() {
+			unableToReadDataSourceError(resp, response.String())
+			return
+		}
+
+		ignoreRules = append(ignoreRules, page.Data...)
+
+		if len(page.Data) < ignoreRulesPageSize || int64(len(ignoreRules)) >= page.TotalCount {
+			break
+		}
+	}
+
+	data.IgnoreRules = make([]xray_resource.IgnoreRuleResourceModel, 0, len(ignoreRules))
+	for _, ignoreRule := range ignoreRules {
+		model := xray_resource.IgnoreRuleResourceModel{
+			ProjectKey: data.ProjectKey,
+		}
+		resp.Diagnostics.Append(model.FromAPIModel(ctx, ignoreRule)...)
+
+		data.IgnoreRules = append(data.IgnoreRules, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+func TestAccDataSourceIgnoreRules_filters(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("ignore-rules-", "data.xray_ignore_rules")
+
+	testData := map[string]string{
+		"resource_name":   resourceName,
+		"cve":             fmt.Sprintf("CVE-2099-%d", testutil.RandomInt()),
+		"expiration_date": time.Now().Add(time.Hour * 48).Format("2006-01-02"),
+	}
+
+	const template = `
+	resource "xray_ignore_rule" "{{ .resource_name }}" {
+		notes           = "fake notes"
+		expiration_date = "{{ .expiration_date }}"
+		cves            = ["{{ .cve }}"]
+
+		component {
+			name    = "fake-component"
+			version = "1.0.0"
+		}
+	}
+
+	data "xray_ignore_rules" "{{ .resource_name }}" {
+		vulnerability = one(xray_ignore_rule.{{ .resource_name }}.cves)
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "ignore_rules.#", "1"),
+					resource.TestCheckResourceAttrPair(fqrn, "ignore_rules.0.id", "xray_ignore_rule."+resourceName, "id"),
+					resource.TestCheckResourceAttr(fqrn, "ignore_rules.0.notes", "fake notes"),
+					resource.TestCheckResourceAttr(fqrn, "ignore_rules.0.expiration_date", testData["expiration_date"]),
+					resource.TestCheckResourceAttr(fqrn, "ignore_rules.0.cves.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "ignore_rules.0.cves.0", testData["cve"]),
+					resource.TestCheckResourceAttr(fqrn, "ignore_rules.0.component.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "ignore_rules.0.component.0.name", "fake-component"),
+				),
+			},
+		},
+	})
+}
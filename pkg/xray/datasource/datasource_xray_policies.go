@@ -0,0 +1,185 @@
+package datasource
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+	"github.com/samber/lo"
+)
+
+var _ datasource.DataSource = &PoliciesDataSource{}
+
+func NewPoliciesDataSource() datasource.DataSource {
+	return &PoliciesDataSource{}
+}
+
+type PoliciesDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type PoliciesDataSourceModel struct {
+	Type       types.String                    `tfsdk:"type"`
+	NameRegex  types.String                    `tfsdk:"name_regex"`
+	ProjectKey types.String                    `tfsdk:"project_key"`
+	Policies   []PoliciesDataSourcePolicyModel `tfsdk:"policies"`
+}
+
+type PoliciesDataSourcePolicyModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Author      types.String `tfsdk:"author"`
+	Created     types.String `tfsdk:"created"`
+	Modified    types.String `tfsdk:"modified"`
+}
+
+func (d *PoliciesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policies"
+}
+
+func (d *PoliciesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *PoliciesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("security", "license", "operational_risk"),
+				},
+				Description: "Only return policies of this type. Allowed values: `security`, `license` or `operational_risk`.",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Only return policies with name matching this regular expression.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "Only return policies of this project. Must be 2 - 10 lowercase alphanumeric and hyphen characters.",
+			},
+			"policies": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the policy.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the policy.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "More verbose description of the policy.",
+						},
+						"author": schema.StringAttribute{
+							Computed:    true,
+							Description: "User, who created the policy.",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation timestamp.",
+						},
+						"modified": schema.StringAttribute{
+							Computed:    true,
+							Description: "Modification timestamp.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "List of policies matching the filters.",
+			},
+		},
+		MarkdownDescription: "Get a list of Xray policies, optionally filtered by type, name and project. See JFrog [Get Policies API documentation](https://jfrog.com/help/r/xray-rest-apis/get-policies) for more details.",
+	}
+}
+
+func (d *PoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PoliciesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		r, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				err.Error(),
+			)
+			return
+		}
+		nameRegex = r
+	}
+
+	request := d.ProviderData.Client.R()
+	if projectKey := data.ProjectKey.ValueString(); projectKey != "" {
+		request.SetQueryParam("projectKey", projectKey)
+	}
+
+	var policies []xray_resource.PolicyAPIModel
+	var policyError xray_resource.PolicyError
+	response, err := request.
+		SetResult(&policies).
+		SetError(&policyError).
+		Get(xray_resource.PoliciesEndpoint)
+
+	if err != nil {
+		unableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		unableToReadDataSourceError(resp, policyError.Error)
+		return
+	}
+
+	policies = lo.Filter(policies, func(policy xray_resource.PolicyAPIModel, _ int) bool {
+		if !data.Type.IsNull() && policy.Type != data.Type.ValueString() {
+			return false
+		}
+
+		return nameRegex == nil || nameRegex.MatchString(policy.Name)
+	})
+
+	data.Policies = lo.Map(policies, func(policy xray_resource.PolicyAPIModel, _ int) PoliciesDataSourcePolicyModel {
+		return PoliciesDataSourcePolicyModel{
+			Name:        types.StringValue(policy.Name),
+			Type:        types.StringValue(policy.Type),
+			Description: types.StringValue(policy.Description),
+			Author:      types.StringValue(policy.Author),
+			Created:     types.StringValue(policy.Created),
+			Modified:    types.StringValue(policy.Modified),
+		}
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,67 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+func TestAccDataSourcePolicies_filters(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policies-", "data.xray_policies")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-license-policy-ds-%d", testutil.RandomInt()),
+	}
+
+	const template = `
+	resource "xray_license_policy" "{{ .resource_name }}" {
+		name        = "{{ .policy_name }}"
+		description = "policy created by xray acceptance tests"
+		type        = "license"
+
+		rule {
+			name     = "license_rule"
+			priority = 1
+
+			criteria {
+				allowed_licenses = ["Apache-1.0", "Apache-2.0"]
+			}
+
+			actions {
+				custom_severity = "High"
+
+				block_download {
+					unscanned = false
+					active    = false
+				}
+			}
+		}
+	}
+
+	data "xray_policies" "{{ .resource_name }}" {
+		type       = "license"
+		name_regex = "^${xray_license_policy.{{ .resource_name }}.name}$"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "policies.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "policies.0.name", testData["policy_name"]),
+					resource.TestCheckResourceAttr(fqrn, "policies.0.type", "license"),
+					resource.TestCheckResourceAttrSet(fqrn, "policies.0.author"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package datasource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+)
+
+var _ datasource.DataSource = &PolicyDataSource{}
+
+func NewPolicyDataSource() datasource.DataSource {
+	return &PolicyDataSource{}
+}
+
+type PolicyDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type PolicyDataSourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	ProjectKey  types.String  `tfsdk:"project_key"`
+	Type        types.String  `tfsdk:"type"`
+	Description types.String  `tfsdk:"description"`
+	Author      types.String  `tfsdk:"author"`
+	Created     types.String  `tfsdk:"created"`
+	Modified    types.String  `tfsdk:"modified"`
+	Rules       types.Dynamic `tfsdk:"rules"`
+}
+
+func (d *PolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (d *PolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *PolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Name of the policy.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "Project key of the policy. Must be 2 - 10 lowercase alphanumeric and hyphen characters.",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "Type of the policy: `security`, `license` or `operational_risk`.",
+			},
+			"description": schema.StringAttribute{
+				Computed:    true,
+				Description: "More verbose description of the policy.",
+			},
+			"author": schema.StringAttribute{
+				Computed:    true,
+				Description: "User, who created the policy.",
+			},
+			"created": schema.StringAttribute{
+				Computed:    true,
+				Description: "Creation timestamp.",
+			},
+			"modified": schema.StringAttribute{
+				Computed:    true,
+				Description: "Modification timestamp.",
+			},
+			"rules": schema.DynamicAttribute{
+				Computed:    true,
+				Description: "Rules of the policy. Each rule has the same shape as the `rule` block of the `xray_security_policy`, `xray_license_policy` or `xray_operational_risk_policy` resource, depending on the policy `type`.",
+			},
+		},
+		MarkdownDescription: "Get an existing Xray policy by name. See JFrog [Get Policy API documentation](https://jfrog.com/help/r/xray-rest-apis/get-policy-details) for more details.",
+	}
+}
+
+func (d *PolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := d.ProviderData.Client.R()
+	if projectKey := data.ProjectKey.ValueString(); projectKey != "" {
+		request.SetQueryParam("projectKey", projectKey)
+	}
+
+	var policy xray_resource.PolicyAPIModel
+	var policyError xray_resource.PolicyError
+	response, err := request.
+		SetPathParam("name", data.Name.ValueString()).
+		SetResult(&policy).
+		SetError(&policyError).
+		Get(xray_resource.PolicyEndpoint)
+
+	if err != nil {
+		unableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Policy not found",
+			"Policy '"+data.Name.ValueString()+"' does not exist.",
+		)
+		return
+	}
+
+	if response.IsError() {
+		unableToReadDataSourceError(resp, policyError.Error)
+		return
+	}
+
+	var model xray_resource.PolicyResourceModel
+	resp.Diagnostics.Append(model.FromAPIModel(ctx, policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = model.ID
+	data.Type = model.Type
+	data.Description = model.Description
+	data.Author = model.Author
+	data.Created = model.Created
+	data.Modified = model.Modified
+	data.Rules = types.DynamicValue(model.Rules)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
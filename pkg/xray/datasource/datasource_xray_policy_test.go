@@ -0,0 +1,73 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+func TestAccDataSourcePolicy_security(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "data.xray_policy")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-security-policy-ds-%d", testutil.RandomInt()),
+		"rule_name":     fmt.Sprintf("test-security-rule-ds-%d", testutil.RandomInt()),
+	}
+
+	const template = `
+	resource "xray_security_policy" "{{ .resource_name }}" {
+		name        = "{{ .policy_name }}"
+		description = "policy created by xray acceptance tests"
+		type        = "security"
+
+		rule {
+			name     = "{{ .rule_name }}"
+			priority = 1
+
+			criteria {
+				min_severity = "High"
+			}
+
+			actions {
+				fail_build = true
+
+				block_download {
+					unscanned = true
+					active    = true
+				}
+			}
+		}
+	}
+
+	data "xray_policy" "{{ .resource_name }}" {
+		name = xray_security_policy.{{ .resource_name }}.name
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["policy_name"]),
+					resource.TestCheckResourceAttr(fqrn, "type", "security"),
+					resource.TestCheckResourceAttr(fqrn, "description", "policy created by xray acceptance tests"),
+					resource.TestCheckResourceAttrSet(fqrn, "author"),
+					resource.TestCheckResourceAttrSet(fqrn, "created"),
+					resource.TestCheckResourceAttr(fqrn, "rules.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "rules.0.name", testData["rule_name"]),
+					resource.TestCheckResourceAttr(fqrn, "rules.0.priority", "1"),
+					resource.TestCheckResourceAttr(fqrn, "rules.0.criteria.0.min_severity", "High"),
+					resource.TestCheckResourceAttr(fqrn, "rules.0.actions.0.fail_build", "true"),
+				),
+			},
+		},
+	})
+}
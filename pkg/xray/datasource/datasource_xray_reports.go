@@ -0,0 +1,237 @@
+package datasource
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+	"github.com/samber/lo"
+)
+
+// reportsPageSize is the number of reports requested per page.
+const reportsPageSize = 100
+
+var _ datasource.DataSource = &ReportsDataSource{}
+
+func NewReportsDataSource() datasource.DataSource {
+	return &ReportsDataSource{}
+}
+
+type ReportsDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type ReportsDataSourceModel struct {
+	NamePrefix types.String                   `tfsdk:"name_prefix"`
+	Type       types.String                   `tfsdk:"type"`
+	Status     types.String                   `tfsdk:"status"`
+	ProjectKey types.String                   `tfsdk:"project_key"`
+	Reports    []ReportsDataSourceReportModel `tfsdk:"reports"`
+}
+
+type ReportsDataSourceReportModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	ReportType types.String `tfsdk:"report_type"`
+	Status     types.String `tfsdk:"status"`
+	Author     types.String `tfsdk:"author"`
+	StartTime  types.String `tfsdk:"start_time"`
+	EndTime    types.String `tfsdk:"end_time"`
+	ProjectKey types.String `tfsdk:"project_key"`
+}
+
+type ReportsListRequestAPIModel struct {
+	Filters ReportsListFiltersAPIModel `json:"filters"`
+}
+
+type ReportsListFiltersAPIModel struct {
+	Name   string   `json:"name,omitempty"`
+	Status []string `json:"status,omitempty"`
+}
+
+type ReportsListAPIModel struct {
+	TotalReports int64                                `json:"total_reports"`
+	Reports      []xray_resource.ReportStatusAPIModel `json:"reports"`
+}
+
+func (d *ReportsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reports"
+}
+
+func (d *ReportsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *ReportsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Only return reports with name starting with this prefix.",
+			},
+			"type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Only return reports of this type, as returned in `report_type`, e.g. `vulnerability`, `license`, `violations`, `operational_risk` or `exposures`. The comparison is case insensitive.",
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pending", "running", "completed", "failed", "aborted"),
+				},
+				Description: "Only return reports with this status. Allowed values: `pending`, `running`, `completed`, `failed` or `aborted`.",
+			},
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "Only return reports of this project. Must be 2 - 10 lowercase alphanumeric and hyphen characters.",
+			},
+			"reports": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the report.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the report.",
+						},
+						"report_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the report.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the report.",
+						},
+						"author": schema.StringAttribute{
+							Computed:    true,
+							Description: "User, who created the report.",
+						},
+						"start_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time when the report generation started.",
+						},
+						"end_time": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time when the report generation ended.",
+						},
+						"project_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "Project key of the report, i.e. the `project_key` the reports are listed for.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "List of reports matching the filters.",
+			},
+		},
+		MarkdownDescription: "Get a list of Xray reports and their status, optionally filtered by name prefix, type, status and project. See JFrog [Get Reports List API documentation](https://jfrog.com/help/r/xray-rest-apis/get-reports-list) for more details.",
+	}
+}
+
+func (d *ReportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ReportsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := ReportsListRequestAPIModel{
+		Filters: ReportsListFiltersAPIModel{
+			Name: data.NamePrefix.ValueString(),
+		},
+	}
+	if !data.Status.IsNull() {
+		body.Filters.Status = []string{data.Status.ValueString()}
+	}
+
+	var reports []xray_resource.ReportStatusAPIModel
+	for pageNum := 1; ; pageNum++ {
+		request := d.ProviderData.Client.R()
+		if projectKey := data.ProjectKey.ValueString(); projectKey != "" {
+			request.SetQueryParam("projectKey", projectKey)
+		}
+
+		var page ReportsListAPIModel
+		response, err := request.
+			SetQueryParams(map[string]string{
+				"page_num":    strconv.Itoa(pageNum),
+				"num_of_rows": strconv.Itoa(reportsPageSize),
+				"order_by":    "name",
+				"direction":   "asc",
+			}).
+			SetBody(body).
+			SetResult(&page).
+			Post(xray_resource.ReportsListEndpoint)
+
+		if err != nil {
+			unableToReadDataSourceError(resp, err.Error())
+			return
+		}
+
+		if response.IsError() {
+			unableToReadDataSourceError(resp, response.String())
+			return
+		}
+
+		reports = append(reports, page.Reports...)
+
+		if len(page.Reports) < reportsPageSize || int64(len(reports)) >= page.TotalReports {
+			break
+		}
+	}
+
+	// The name filter of the API matches substrings, the other filters are
+	// applied again to not depend on the API version.
+	reports = lo.Filter(reports, func(report xray_resource.ReportStatusAPIModel, _ int) bool {
+		if !data.NamePrefix.IsNull() && !strings.HasPrefix(report.Name, data.NamePrefix.ValueString()) {
+			return false
+		}
+
+		if !data.Type.IsNull() && !strings.EqualFold(report.ReportType, data.Type.ValueString()) {
+			return false
+		}
+
+		return data.Status.IsNull() || report.Status == data.Status.ValueString()
+	})
+
+	data.Reports = lo.Map(reports, func(report xray_resource.ReportStatusAPIModel, _ int) ReportsDataSourceReportModel {
+		return ReportsDataSourceReportModel{
+			ID:         types.StringValue(strconv.FormatInt(report.ID, 10)),
+			Name:       types.StringValue(report.Name),
+			ReportType: types.StringValue(report.ReportType),
+			Status:     types.StringValue(report.Status),
+			Author:     types.StringValue(report.Author),
+			StartTime:  types.StringValue(report.StartTime),
+			EndTime:    types.StringValue(report.EndTime),
+			ProjectKey: data.ProjectKey,
+		}
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+func TestAccDataSourceReports_filters(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("reports-", "data.xray_reports")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"report_name":   fmt.Sprintf("terraform-reports-ds-%d", testutil.RandomInt()),
+	}
+
+	const template = `
+	resource "xray_licenses_report" "{{ .resource_name }}" {
+		name = "{{ .report_name }}"
+
+		resources {
+			repository {
+				name = "repository-name"
+			}
+		}
+
+		filters {
+			unrecognized = true
+		}
+	}
+
+	data "xray_reports" "{{ .resource_name }}" {
+		name_prefix = xray_licenses_report.{{ .resource_name }}.name
+		status      = "completed"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "reports.#", "1"),
+					resource.TestCheckResourceAttrPair(fqrn, "reports.0.id", "xray_licenses_report."+resourceName, "id"),
+					resource.TestCheckResourceAttr(fqrn, "reports.0.name", testData["report_name"]),
+					resource.TestCheckResourceAttr(fqrn, "reports.0.status", "completed"),
+					resource.TestCheckResourceAttrSet(fqrn, "reports.0.report_type"),
+					resource.TestCheckResourceAttrSet(fqrn, "reports.0.author"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,376 @@
+package datasource
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+	"github.com/samber/lo"
+)
+
+const ViolationsEndpoint = "xray/api/v1/violations"
+
+// violationsPageSize is the number of violations requested per page.
+const violationsPageSize = 100
+
+// violationTypes maps the violation types of the data source to the ones of
+// the Get Violations API.
+var violationTypes = map[string]string{
+	"security":         "Security",
+	"license":          "License",
+	"operational_risk": "Operational_Risk",
+}
+
+var artifactPathRegex = regexp.MustCompile(`^[^/]+/.+$`)
+
+var _ datasource.DataSource = &ViolationsDataSource{}
+
+func NewViolationsDataSource() datasource.DataSource {
+	return &ViolationsDataSource{}
+}
+
+type ViolationsDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type ViolationsDataSourceModel struct {
+	Watch        types.String                         `tfsdk:"watch"`
+	Policy       types.String                         `tfsdk:"policy"`
+	Type         types.String                         `tfsdk:"type"`
+	MinSeverity  types.String                         `tfsdk:"min_severity"`
+	Component    types.String                         `tfsdk:"component"`
+	Artifact     types.String                         `tfsdk:"artifact"`
+	CreatedFrom  types.String                         `tfsdk:"created_from"`
+	CreatedUntil types.String                         `tfsdk:"created_until"`
+	Violations   []ViolationsDataSourceViolationModel `tfsdk:"violations"`
+}
+
+type ViolationsDataSourceViolationModel struct {
+	IssueID            types.String                             `tfsdk:"issue_id"`
+	Type               types.String                             `tfsdk:"type"`
+	Severity           types.String                             `tfsdk:"severity"`
+	Summary            types.String                             `tfsdk:"summary"`
+	Created            types.String                             `tfsdk:"created"`
+	WatchName          types.String                             `tfsdk:"watch_name"`
+	CVEs               []types.String                           `tfsdk:"cves"`
+	InfectedComponents []types.String                           `tfsdk:"infected_components"`
+	ImpactedArtifacts  []types.String                           `tfsdk:"impacted_artifacts"`
+	MatchedPolicies    []ViolationsDataSourceMatchedPolicyModel `tfsdk:"matched_policies"`
+}
+
+type ViolationsDataSourceMatchedPolicyModel struct {
+	Policy     types.String `tfsdk:"policy"`
+	Rule       types.String `tfsdk:"rule"`
+	IsBlocking types.Bool   `tfsdk:"is_blocking"`
+}
+
+type ViolationsRequestAPIModel struct {
+	Filters    ViolationsFiltersAPIModel    `json:"filters"`
+	Pagination ViolationsPaginationAPIModel `json:"pagination"`
+}
+
+type ViolationsFiltersAPIModel struct {
+	WatchName     string                       `json:"watch_name,omitempty"`
+	ViolationType string                       `json:"violation_type,omitempty"`
+	MinSeverity   string                       `json:"min_severity,omitempty"`
+	CreatedFrom   string                       `json:"created_from,omitempty"`
+	CreatedUntil  string                       `json:"created_until,omitempty"`
+	Resources     *ViolationsResourcesAPIModel `json:"resources,omitempty"`
+}
+
+type ViolationsResourcesAPIModel struct {
+	Artifacts []ViolationsArtifactAPIModel `json:"artifacts"`
+}
+
+type ViolationsArtifactAPIModel struct {
+	Repo string `json:"repo"`
+	Path string `json:"path"`
+}
+
+type ViolationsPaginationAPIModel struct {
+	OrderBy   string `json:"order_by"`
+	Direction string `json:"direction"`
+	Limit     int    `json:"limit"`
+	Offset    int    `json:"offset"`
+}
+
+type ViolationsAPIModel struct {
+	TotalViolations int64               `json:"total_violations"`
+	Violations      []ViolationAPIModel `json:"violations"`
+}
+
+type ViolationAPIModel struct {
+	IssueID            string                           `json:"issue_id"`
+	Type               string                           `json:"type"`
+	Severity           string                           `json:"severity"`
+	Description        string                           `json:"description"`
+	Created            string                           `json:"created"`
+	WatchName          string                           `json:"watch_name"`
+	InfectedComponents []string                         `json:"infected_components"`
+	ImpactedArtifacts  []string                         `json:"impacted_artifacts"`
+	MatchedPolicies    []ViolationMatchedPolicyAPIModel `json:"matched_policies"`
+	Properties         []ViolationPropertyAPIModel      `json:"properties"`
+}
+
+type ViolationMatchedPolicyAPIModel struct {
+	Policy     string `json:"policy"`
+	Rule       string `json:"rule"`
+	IsBlocking bool   `json:"is_blocking"`
+}
+
+type ViolationPropertyAPIModel struct {
+	CVE string `json:"cve"`
+}
+
+func toStringValue(value string, _ int) types.String {
+	return types.StringValue(value)
+}
+
+// filters returns the filters of the Get Violations API which are set in the
+// configuration.
+func (m ViolationsDataSourceModel) filters() ViolationsFiltersAPIModel {
+	filters := ViolationsFiltersAPIModel{
+		WatchName:     m.Watch.ValueString(),
+		ViolationType: violationTypes[m.Type.ValueString()],
+		MinSeverity:   m.MinSeverity.ValueString(),
+		CreatedFrom:   m.CreatedFrom.ValueString(),
+		CreatedUntil:  m.CreatedUntil.ValueString(),
+	}
+
+	if !m.Artifact.IsNull() {
+		repo, artifactPath, _ := strings.Cut(m.Artifact.ValueString(), "/")
+		filters.Resources = &ViolationsResourcesAPIModel{
+			Artifacts: []ViolationsArtifactAPIModel{
+				{
+					Repo: repo,
+					Path: artifactPath,
+				},
+			},
+		}
+	}
+
+	return filters
+}
+
+func (d *ViolationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_violations"
+}
+
+func (d *ViolationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *ViolationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"watch":     stringFilterAttribute("Only return violations of this watch."),
+			"policy":    stringFilterAttribute("Only return violations matching this policy."),
+			"component": stringFilterAttribute("Only return violations with an infected component containing this value, e.g. `npm://lodash` or `npm://lodash:4.17.4`."),
+			"type": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("security", "license", "operational_risk"),
+				},
+				Description: "Only return violations of this type. Allowed values: `security`, `license` or `operational_risk`.",
+			},
+			"min_severity": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Critical", "High", "Medium", "Low"),
+				},
+				Description: "Only return violations with this severity or higher. Allowed values: `Critical`, `High`, `Medium` or `Low`.",
+			},
+			"artifact": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(artifactPathRegex, "must be in the format 'repository/path/to/artifact'"),
+				},
+				Description: "Only return violations impacting this artifact, in the format `repository/path/to/artifact`, e.g. `docker-local/my-image/1.0.0/manifest.json`.",
+			},
+			"created_from": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					xray_resource.IsRFC3339Time(),
+				},
+				Description: "Only return violations created after this time, in RFC3339 format, e.g. `2025-01-01T00:00:00Z`.",
+			},
+			"created_until": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					xray_resource.IsRFC3339Time(),
+					stringvalidator.AlsoRequires(path.MatchRoot("created_from")),
+				},
+				Description: "Only return violations created before this time, in RFC3339 format, e.g. `2025-01-31T00:00:00Z`. Requires `created_from`.",
+			},
+			"violations": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"issue_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the issue, e.g. `XRAY-123456`.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the violation: `Security`, `License` or `Operational_Risk`.",
+						},
+						"severity": schema.StringAttribute{
+							Computed:    true,
+							Description: "Severity of the violation.",
+						},
+						"summary": schema.StringAttribute{
+							Computed:    true,
+							Description: "Summary of the violation.",
+						},
+						"created": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time when the violation was created.",
+						},
+						"watch_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name of the watch which raised the violation.",
+						},
+						"cves": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "CVEs of the issue.",
+						},
+						"infected_components": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Infected components, e.g. `npm://lodash:4.17.4`.",
+						},
+						"impacted_artifacts": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Artifacts impacted by the violation.",
+						},
+						"matched_policies": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"policy": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the policy.",
+									},
+									"rule": schema.StringAttribute{
+										Computed:    true,
+										Description: "Name of the policy rule.",
+									},
+									"is_blocking": schema.BoolAttribute{
+										Computed:    true,
+										Description: "Whether the policy rule blocks the download of the impacted artifacts.",
+									},
+								},
+							},
+							Computed:    true,
+							Description: "Policies matched by the violation.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "List of violations matching the filters.",
+			},
+		},
+		MarkdownDescription: "Get a list of current Xray violations, optionally filtered by watch, policy, type, minimum severity, component, artifact and creation date. See JFrog [Get Violations API documentation](https://jfrog.com/help/r/xray-rest-apis/get-violations) for more details.",
+	}
+}
+
+func (d *ViolationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ViolationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := ViolationsRequestAPIModel{
+		Filters: data.filters(),
+		Pagination: ViolationsPaginationAPIModel{
+			OrderBy:   "created",
+			Direction: "asc",
+			Limit:     violationsPageSize,
+		},
+	}
+
+	var violations []ViolationAPIModel
+	for pageNum := 1; ; pageNum++ {
+		body.Pagination.Offset = pageNum
+
+		var page ViolationsAPIModel
+		response, err := d.ProviderData.Client.R().
+			SetBody(body).
+			SetResult(&page).
+			Post(ViolationsEndpoint)
+
+		if err != nil {
+			unableToReadDataSourceError(resp, err.Error())
+			return
+		}
+
+		if response.IsError() {
+			unableToReadDataSourceError(resp, response.String())
+			return
+		}
+
+		violations = append(violations, page.Violations...)
+
+		if len(page.Violations) < violationsPageSize || int64(len(violations)) >= page.TotalViolations {
+			break
+		}
+	}
+
+	// The API has no filter on policies and components, they are applied on
+	// the returned violations.
+	violations = lo.Filter(violations, func(violation ViolationAPIModel, _ int) bool {
+		if !data.Policy.IsNull() && !lo.ContainsBy(violation.MatchedPolicies, func(policy ViolationMatchedPolicyAPIModel) bool {
+			return policy.Policy == data.Policy.ValueString()
+		}) {
+			return false
+		}
+
+		return data.Component.IsNull() || lo.ContainsBy(violation.InfectedComponents, func(component string) bool {
+			return strings.Contains(component, data.Component.ValueString())
+		})
+	})
+
+	data.Violations = lo.Map(violations, func(violation ViolationAPIModel, _ int) ViolationsDataSourceViolationModel {
+		cves := lo.Uniq(lo.FilterMap(violation.Properties, func(property ViolationPropertyAPIModel, _ int) (string, bool) {
+			return property.CVE, property.CVE != ""
+		}))
+
+		return ViolationsDataSourceViolationModel{
+			IssueID:            types.StringValue(violation.IssueID),
+			Type:               types.StringValue(violation.Type),
+			Severity:           types.StringValue(violation.Severity),
+			Summary:            types.StringValue(violation.Description),
+			Created:            types.StringValue(violation.Created),
+			WatchName:          types.StringValue(violation.WatchName),
+			CVEs:               lo.Map(cves, toStringValue),
+			InfectedComponents: lo.Map(violation.InfectedComponents, toStringValue),
+			ImpactedArtifacts:  lo.Map(violation.ImpactedArtifacts, toStringValue),
+			MatchedPolicies: lo.Map(violation.MatchedPolicies, func(policy ViolationMatchedPolicyAPIModel, _ int) ViolationsDataSourceMatchedPolicyModel {
+				return ViolationsDataSourceMatchedPolicyModel{
+					Policy:     types.StringValue(policy.Policy),
+					Rule:       types.StringValue(policy.Rule),
+					IsBlocking: types.BoolValue(policy.IsBlocking),
+				}
+			}),
+		}
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,105 @@
+package datasource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+func TestAccDataSourceViolations_watch(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("violations-", "data.xray_violations")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-violations-ds-%d", testutil.RandomInt()),
+		"watch_name":    fmt.Sprintf("terraform-violations-ds-%d", testutil.RandomInt()),
+	}
+
+	const template = `
+	resource "xray_security_policy" "{{ .resource_name }}" {
+		name        = "{{ .policy_name }}"
+		description = "policy created by xray acceptance tests"
+		type        = "security"
+
+		rule {
+			name     = "rule-name-severity"
+			priority = 1
+
+			criteria {
+				min_severity = "High"
+			}
+
+			actions {
+				fail_build = true
+
+				block_download {
+					unscanned = true
+					active    = true
+				}
+			}
+		}
+	}
+
+	resource "xray_watch" "{{ .resource_name }}" {
+		name        = "{{ .watch_name }}"
+		description = "watch created by xray acceptance tests"
+		active      = true
+
+		watch_resource {
+			type = "all-repos"
+		}
+
+		assigned_policy {
+			name = xray_security_policy.{{ .resource_name }}.name
+			type = "security"
+		}
+	}
+
+	data "xray_violations" "{{ .resource_name }}" {
+		watch        = xray_watch.{{ .resource_name }}.name
+		type         = "security"
+		min_severity = "High"
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "watch", testData["watch_name"]),
+					resource.TestCheckResourceAttr(fqrn, "violations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceViolations_invalid_type(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("violations-", "data.xray_violations")
+
+	config := util.ExecuteTemplate(fqrn, `
+	data "xray_violations" "{{ .resource_name }}" {
+		type = "invalid"
+	}
+	`, map[string]string{
+		"resource_name": resourceName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Attribute type value must be one of.*`),
+			},
+		},
+	})
+}
@@ -0,0 +1,233 @@
+package datasource
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+)
+
+var _ datasource.DataSource = &WatchDataSource{}
+
+func NewWatchDataSource() datasource.DataSource {
+	return &WatchDataSource{}
+}
+
+type WatchDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+func (d *WatchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_watch"
+}
+
+func (d *WatchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+var antFilterSchemaAttributes = map[string]schema.Attribute{
+	"include_patterns": schema.ListAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "Ant-style wildcard patterns included in this watch.",
+	},
+	"exclude_patterns": schema.ListAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "Ant-style wildcard patterns excluded from this watch.",
+	},
+}
+
+// watchSchemaAttributes mirrors the attributes and blocks of the xray_watch
+// resource so the decoded values have the same shape.
+var watchSchemaAttributes = map[string]schema.Attribute{
+	"description": schema.StringAttribute{
+		Computed:    true,
+		Description: "Description of the watch.",
+	},
+	"active": schema.BoolAttribute{
+		Computed:    true,
+		Description: "Whether or not the watch is active.",
+	},
+	"watch_recipients": schema.SetAttribute{
+		ElementType: types.StringType,
+		Computed:    true,
+		Description: "A list of email addressed that will get emailed when a violation is triggered.",
+	},
+	"watch_resource": schema.SetNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Computed:    true,
+					Description: "Type of resource watched.",
+				},
+				"bin_mgr_id": schema.StringAttribute{
+					Computed:    true,
+					Description: "The ID number of a binary manager resource.",
+				},
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "The name of the build, repository, project, or release bundle.",
+				},
+				"repo_type": schema.StringAttribute{
+					Computed:    true,
+					Description: "Type of repository. Only set when `type` is `repository`.",
+				},
+				"filter": schema.SetNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Computed:    true,
+								Description: "The type of filter, such as `regex`, `path-regex`, `package-type`, or `mime-type`.",
+							},
+							"value": schema.StringAttribute{
+								Computed:    true,
+								Description: "The value of the filter.",
+							},
+						},
+					},
+					Computed:    true,
+					Description: "Filters for `regex`, `path-regex`, `package-type` and `mime-type` type.",
+				},
+				"ant_filter": schema.SetNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: antFilterSchemaAttributes,
+					},
+					Computed:    true,
+					Description: "`ant-patterns` filters.",
+				},
+				"path_ant_filter": schema.SetNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: antFilterSchemaAttributes,
+					},
+					Computed:    true,
+					Description: "`path-ant-patterns` filters.",
+				},
+				"kv_filter": schema.SetNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Computed:    true,
+								Description: "The type of filter.",
+							},
+							"key": schema.StringAttribute{
+								Computed:    true,
+								Description: "The property name of the artifact.",
+							},
+							"value": schema.StringAttribute{
+								Computed:    true,
+								Description: "The property value of the artifact.",
+							},
+						},
+					},
+					Computed:    true,
+					Description: "Filters for `property` type.",
+				},
+			},
+		},
+		Computed:    true,
+		Description: "Resources watched. Same structure as the `watch_resource` block of the `xray_watch` resource.",
+	},
+	"assigned_policy": schema.SetNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Computed:    true,
+					Description: "The name of the policy applied.",
+				},
+				"type": schema.StringAttribute{
+					Computed:    true,
+					Description: "The type of the policy - security, license or operational risk.",
+				},
+			},
+		},
+		Computed:    true,
+		Description: "Policies applied by the watch.",
+	},
+}
+
+func (d *WatchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+			Description: "Name of the watch.",
+		},
+		"project_key": schema.StringAttribute{
+			Optional: true,
+			Validators: []validator.String{
+				validatorfw_string.ProjectKey(),
+			},
+			Description: "Project key of the watch. Must be 2 - 10 lowercase alphanumeric and hyphen characters.",
+		},
+	}
+	for name, attribute := range watchSchemaAttributes {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes:          attributes,
+		MarkdownDescription: "Get an existing Xray watch by name. See JFrog [Get Watch API documentation](https://jfrog.com/help/r/xray-rest-apis/get-watch) for more details.",
+	}
+}
+
+func (d *WatchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data xray_resource.WatchResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := d.ProviderData.Client.R()
+	if projectKey := data.ProjectKey.ValueString(); projectKey != "" {
+		request.SetQueryParam("projectKey", projectKey)
+	}
+
+	var watch xray_resource.WatchAPIModel
+	response, err := request.
+		SetPathParam("name", data.Name.ValueString()).
+		SetResult(&watch).
+		Get(xray_resource.WatchEndpoint)
+
+	if err != nil {
+		unableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Watch not found",
+			"Watch '"+data.Name.ValueString()+"' does not exist.",
+		)
+		return
+	}
+
+	if response.IsError() {
+		unableToReadDataSourceError(resp, response.String())
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPIModel(ctx, watch)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,99 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+const watchDataSourceTemplate = `
+resource "xray_security_policy" "{{ .resource_name }}" {
+	name        = "{{ .policy_name }}"
+	description = "policy created by xray acceptance tests"
+	type        = "security"
+
+	rule {
+		name     = "rule-name-severity"
+		priority = 1
+
+		criteria {
+			min_severity = "High"
+		}
+
+		actions {
+			fail_build = true
+
+			block_download {
+				unscanned = true
+				active    = true
+			}
+		}
+	}
+}
+
+resource "xray_watch" "{{ .resource_name }}" {
+	name        = "{{ .watch_name }}"
+	description = "watch created by xray acceptance tests"
+	active      = true
+
+	watch_resource {
+		type = "all-repos"
+
+		filter {
+			type  = "regex"
+			value = ".*"
+		}
+	}
+
+	assigned_policy {
+		name = xray_security_policy.{{ .resource_name }}.name
+		type = "security"
+	}
+
+	watch_recipients = ["test@email.com"]
+}
+`
+
+func TestAccDataSourceWatch_allRepos(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("watch-", "data.xray_watch")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-security-policy-ds-%d", testutil.RandomInt()),
+		"watch_name":    fmt.Sprintf("terraform-watch-ds-%d", testutil.RandomInt()),
+	}
+
+	const template = watchDataSourceTemplate + `
+	data "xray_watch" "{{ .resource_name }}" {
+		name = xray_watch.{{ .resource_name }}.name
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["watch_name"]),
+					resource.TestCheckResourceAttr(fqrn, "description", "watch created by xray acceptance tests"),
+					resource.TestCheckResourceAttr(fqrn, "active", "true"),
+					resource.TestCheckResourceAttr(fqrn, "watch_resource.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "watch_resource.0.type", "all-repos"),
+					resource.TestCheckResourceAttr(fqrn, "watch_resource.0.filter.0.type", "regex"),
+					resource.TestCheckResourceAttr(fqrn, "watch_resource.0.filter.0.value", ".*"),
+					resource.TestCheckResourceAttr(fqrn, "assigned_policy.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "assigned_policy.0.name", testData["policy_name"]),
+					resource.TestCheckResourceAttr(fqrn, "assigned_policy.0.type", "security"),
+					resource.TestCheckResourceAttr(fqrn, "watch_recipients.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "watch_recipients.0", "test@email.com"),
+				),
+			},
+		},
+	})
+}
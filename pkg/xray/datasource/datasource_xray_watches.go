@@ -0,0 +1,123 @@
+package datasource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
+	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+)
+
+var _ datasource.DataSource = &WatchesDataSource{}
+
+func NewWatchesDataSource() datasource.DataSource {
+	return &WatchesDataSource{}
+}
+
+type WatchesDataSource struct {
+	ProviderData util.ProviderMetadata
+}
+
+type WatchesDataSourceModel struct {
+	ProjectKey types.String                       `tfsdk:"project_key"`
+	Watches    []xray_resource.WatchResourceModel `tfsdk:"watches"`
+}
+
+func (d *WatchesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_watches"
+}
+
+func (d *WatchesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (d *WatchesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	watchAttributes := map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "Name of the watch.",
+		},
+		"project_key": schema.StringAttribute{
+			Computed:    true,
+			Description: "Project key of the watch.",
+		},
+	}
+	for name, attribute := range watchSchemaAttributes {
+		watchAttributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"project_key": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validatorfw_string.ProjectKey(),
+				},
+				Description: "Only return watches of this project. Must be 2 - 10 lowercase alphanumeric and hyphen characters.",
+			},
+			"watches": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: watchAttributes,
+				},
+				Computed:    true,
+				Description: "List of watches.",
+			},
+		},
+		MarkdownDescription: "Get a list of Xray watches. See JFrog [Get All Watches API documentation](https://jfrog.com/help/r/xray-rest-apis/get-all-watches) for more details.",
+	}
+}
+
+func (d *WatchesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WatchesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := d.ProviderData.Client.R()
+	if projectKey := data.ProjectKey.ValueString(); projectKey != "" {
+		request.SetQueryParam("projectKey", projectKey)
+	}
+
+	var watches []xray_resource.WatchAPIModel
+	response, err := request.
+		SetResult(&watches).
+		Get(xray_resource.WatchesEndpoint)
+
+	if err != nil {
+		unableToReadDataSourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		unableToReadDataSourceError(resp, response.String())
+		return
+	}
+
+	data.Watches = make([]xray_resource.WatchResourceModel, 0, len(watches))
+	for _, watch := range watches {
+		model := xray_resource.WatchResourceModel{
+			ProjectKey: data.ProjectKey,
+		}
+		resp.Diagnostics.Append(model.FromAPIModel(ctx, watch)...)
+
+		data.Watches = append(data.Watches, model)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,47 @@
+package datasource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+func TestAccDataSourceWatches_list(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("watches-", "data.xray_watches")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-security-policy-ds-%d", testutil.RandomInt()),
+		"watch_name":    fmt.Sprintf("terraform-watch-ds-%d", testutil.RandomInt()),
+	}
+
+	const template = watchDataSourceTemplate + `
+	data "xray_watches" "{{ .resource_name }}" {
+		depends_on = [xray_watch.{{ .resource_name }}]
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "watches.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(fqrn, "watches.*", map[string]string{
+						"name":               testData["watch_name"],
+						"active":             "true",
+						"assigned_policy.#":  "1",
+						"watch_resource.#":   "1",
+						"watch_recipients.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
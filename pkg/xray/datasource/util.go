@@ -0,0 +1,14 @@
+package datasource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func unableToReadDataSourceError(resp *datasource.ReadResponse, err string) {
+	resp.Diagnostics.AddError(
+		"Unable to read data source",
+		"An unexpected error occurred while attempting to reading data source state. "+
+			"Please retry the operation or report this issue to the provider developers.\n\n"+
+			"Error: "+err,
+	)
+}
@@ -0,0 +1,96 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var _ function.Function = &IgnoreRulesFromVEXFunction{}
+
+func NewIgnoreRulesFromVEXFunction() function.Function {
+	return &IgnoreRulesFromVEXFunction{}
+}
+
+type IgnoreRulesFromVEXFunction struct{}
+
+var ignoreRuleComponentAttributeTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"version": types.StringType,
+}
+
+var ignoreRuleFromVEXAttributeTypes = map[string]attr.Type{
+	"cve":       types.StringType,
+	"notes":     types.StringType,
+	"component": types.ObjectType{AttrTypes: ignoreRuleComponentAttributeTypes},
+}
+
+func (f *IgnoreRulesFromVEXFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ignore_rules_from_vex"
+}
+
+func (f *IgnoreRulesFromVEXFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts the not affected statements of a VEX document to ignore rules",
+		MarkdownDescription: "Parses an [OpenVEX](https://github.com/openvex/spec) or [CycloneDX VEX](https://cyclonedx.org/capabilities/vex/) JSON document and returns an ignore rule for every component stated as `not_affected` by a vulnerability. " +
+			"Each ignore rule has the vulnerability ID in `cve`, the justification in `notes` and the component in `component`, with package URLs converted to Xray component names, e.g. `pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1` to `gav://org.apache.logging.log4j:log4j-core` and `2.14.1`. " +
+			"The document is parsed locally, no request is sent to Xray.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "OpenVEX or CycloneDX VEX JSON document, e.g. `file(\"vex.json\")`.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: ignoreRuleFromVEXAttributeTypes},
+		},
+	}
+}
+
+func (f *IgnoreRulesFromVEXFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document))
+	if resp.Error != nil {
+		return
+	}
+
+	rules, err := parseVEX([]byte(document))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	ignoreRules := lo.Map(rules, func(rule vexIgnoreRule, _ int) attr.Value {
+		version := types.StringNull()
+		if rule.ComponentVersion != "" {
+			version = types.StringValue(rule.ComponentVersion)
+		}
+
+		return types.ObjectValueMust(
+			ignoreRuleFromVEXAttributeTypes,
+			map[string]attr.Value{
+				"cve":   types.StringValue(rule.CVE),
+				"notes": types.StringValue(rule.Notes),
+				"component": types.ObjectValueMust(
+					ignoreRuleComponentAttributeTypes,
+					map[string]attr.Value{
+						"name":    types.StringValue(rule.ComponentName),
+						"version": version,
+					},
+				),
+			},
+		)
+	})
+
+	result, diags := types.ListValue(types.ObjectType{AttrTypes: ignoreRuleFromVEXAttributeTypes}, ignoreRules)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
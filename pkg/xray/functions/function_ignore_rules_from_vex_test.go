@@ -0,0 +1,217 @@
+package functions_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/xray/functions"
+)
+
+type ignoreRule struct {
+	CVE       string `tfsdk:"cve"`
+	Notes     string `tfsdk:"notes"`
+	Component struct {
+		Name    string       `tfsdk:"name"`
+		Version types.String `tfsdk:"version"`
+	} `tfsdk:"component"`
+}
+
+func runIgnoreRulesFromVEX(t *testing.T, document string) ([]ignoreRule, *function.FuncError) {
+	ctx := context.Background()
+	f := functions.NewIgnoreRulesFromVEXFunction()
+
+	definitionResp := function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, &definitionResp)
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(definitionResp.Definition.Return.GetType().(types.ListType).ElemType)),
+	}
+	f.Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(document)}),
+	}, &resp)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	var rules []ignoreRule
+	diags := resp.Result.Value().(types.List).ElementsAs(ctx, &rules, false)
+	if diags.HasError() {
+		t.Fatalf("failed to read result: %v", diags)
+	}
+
+	return rules, nil
+}
+
+func TestIgnoreRulesFromVEX_OpenVEX(t *testing.T) {
+	rules, err := runIgnoreRulesFromVEX(t, `{
+		"@context": "https://openvex.dev/ns/v0.2.0",
+		"@id": "https://openvex.dev/docs/example/vex-9fb3463de1b57",
+		"statements": [
+			{
+				"vulnerability": {"name": "CVE-2021-44228"},
+				"products": [
+					{
+						"@id": "pkg:oci/my-app@sha256%3Aabc",
+						"subcomponents": [
+							{"@id": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"}
+						]
+					}
+				],
+				"status": "not_affected",
+				"justification": "vulnerable_code_not_in_execute_path",
+				"impact_statement": "JNDI lookups are disabled"
+			},
+			{
+				"vulnerability": {"name": "CVE-2022-22965"},
+				"products": [{"@id": "pkg:npm/%40angular/core@12.0.0"}],
+				"status": "affected"
+			},
+			{
+				"vulnerability": "CVE-2023-44487",
+				"products": ["pkg:golang/golang.org/x/net@v0.7.0"],
+				"status": "not_affected",
+				"justification": "vulnerable_code_not_present"
+			}
+		]
+	}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 ignore rules, got %d: %+v", len(rules), rules)
+	}
+
+	if rules[0].CVE != "CVE-2021-44228" {
+		t.Errorf("expected CVE-2021-44228, got %s", rules[0].CVE)
+	}
+	if rules[0].Notes != "Not affected (vulnerable_code_not_in_execute_path): JNDI lookups are disabled" {
+		t.Errorf("unexpected notes: %s", rules[0].Notes)
+	}
+	if rules[0].Component.Name != "gav://org.apache.logging.log4j:log4j-core" || rules[0].Component.Version.ValueString() != "2.14.1" {
+		t.Errorf("unexpected component: %+v", rules[0].Component)
+	}
+
+	if rules[1].CVE != "CVE-2023-44487" {
+		t.Errorf("expected CVE-2023-44487, got %s", rules[1].CVE)
+	}
+	if rules[1].Notes != "Not affected (vulnerable_code_not_present)" {
+		t.Errorf("unexpected notes: %s", rules[1].Notes)
+	}
+	if rules[1].Component.Name != "go://golang.org/x/net" || rules[1].Component.Version.ValueString() != "v0.7.0" {
+		t.Errorf("unexpected component: %+v", rules[1].Component)
+	}
+}
+
+func TestIgnoreRulesFromVEX_CycloneDX(t *testing.T) {
+	rules, err := runIgnoreRulesFromVEX(t, `{
+		"bomFormat": "CycloneDX",
+		"specVersion": "1.5",
+		"components": [
+			{
+				"bom-ref": "angular-core",
+				"type": "library",
+				"name": "core",
+				"version": "12.0.0",
+				"purl": "pkg:npm/%40angular/core@12.0.0"
+			},
+			{
+				"bom-ref": "internal-lib",
+				"type": "library",
+				"group": "com.example",
+				"name": "internal-lib",
+				"version": "1.0.0"
+			}
+		],
+		"vulnerabilities": [
+			{
+				"id": "CVE-2022-22965",
+				"analysis": {
+					"state": "not_affected",
+					"justification": "code_not_reachable",
+					"detail": "Only used at build time"
+				},
+				"affects": [
+					{"ref": "angular-core"},
+					{"ref": "urn:cdx:3e671687-395b-41f5-a30f-a58921a69b79/1#internal-lib"},
+					{"ref": "pkg:pypi/django@4.2.0"}
+				]
+			},
+			{
+				"id": "CVE-2023-44487",
+				"analysis": {"state": "exploitable"},
+				"affects": [{"ref": "angular-core"}]
+			}
+		]
+	}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(rules) != 3 {
+		t.Fatalf("expected 3 ignore rules, got %d: %+v", len(rules), rules)
+	}
+
+	expected := []struct {
+		name    string
+		version string
+	}{
+		{name: "npm://@angular/core", version: "12.0.0"},
+		{name: "com.example:internal-lib", version: "1.0.0"},
+		{name: "pypi://django", version: "4.2.0"},
+	}
+	for idx, e := range expected {
+		if rules[idx].CVE != "CVE-2022-22965" {
+			t.Errorf("expected CVE-2022-22965, got %s", rules[idx].CVE)
+		}
+		if rules[idx].Notes != "Not affected (code_not_reachable): Only used at build time" {
+			t.Errorf("unexpected notes: %s", rules[idx].Notes)
+		}
+		if rules[idx].Component.Name != e.name || rules[idx].Component.Version.ValueString() != e.version {
+			t.Errorf("expected component %s %s, got %+v", e.name, e.version, rules[idx].Component)
+		}
+	}
+}
+
+func TestIgnoreRulesFromVEX_invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		document      string
+		expectedError string
+	}{
+		{
+			name:          "not_json",
+			document:      "not json",
+			expectedError: "failed to parse VEX document",
+		},
+		{
+			name:          "unsupported_format",
+			document:      `{"bomFormat": "SPDX"}`,
+			expectedError: "unsupported VEX document",
+		},
+		{
+			name:          "unknown_ref",
+			document:      `{"bomFormat": "CycloneDX", "vulnerabilities": [{"id": "CVE-2021-44228", "analysis": {"state": "not_affected"}, "affects": [{"ref": "missing"}]}]}`,
+			expectedError: "unknown component reference 'missing'",
+		},
+		{
+			name:          "no_product",
+			document:      `{"@context": "https://openvex.dev/ns/v0.2.0", "statements": [{"vulnerability": {"name": "CVE-2021-44228"}, "status": "not_affected"}]}`,
+			expectedError: "has no product",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runIgnoreRulesFromVEX(t, tc.document)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Text, tc.expectedError) {
+				t.Errorf("expected error to contain '%s', got '%s'", tc.expectedError, err.Text)
+			}
+		})
+	}
+}
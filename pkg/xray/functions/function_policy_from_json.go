@@ -0,0 +1,99 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+)
+
+var _ function.Function = &PolicyFromJSONFunction{}
+
+func NewPolicyFromJSONFunction() function.Function {
+	return &PolicyFromJSONFunction{}
+}
+
+type PolicyFromJSONFunction struct{}
+
+func (f *PolicyFromJSONFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "policy_from_json"
+}
+
+func (f *PolicyFromJSONFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a policy exported from Xray to the arguments of a policy resource",
+		MarkdownDescription: "Parses a policy JSON document, as returned by the Xray [Get Policy API](https://jfrog.com/help/r/xray-rest-apis/get-policy-details), and returns an object with its `name`, `type`, `description` and `rules`. " +
+			"Each rule has the same shape as the `rule` block of the `xray_security_policy`, `xray_license_policy` or `xray_operational_risk_policy` resource, depending on the policy `type`, to be used in `dynamic \"rule\"` blocks. " +
+			"The document is parsed locally, no request is sent to Xray.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "json",
+				MarkdownDescription: "Policy JSON document, e.g. `file(\"policy.json\")`. Policies exported as a list can be converted one at a time with `jsonencode`.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+var policyFromJSONAttributeTypes = func(rulesType attr.Type) map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"type":        types.StringType,
+		"description": types.StringType,
+		"rules":       rulesType,
+	}
+}
+
+func (f *PolicyFromJSONFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &document))
+	if resp.Error != nil {
+		return
+	}
+
+	var policy xray_resource.PolicyAPIModel
+	if err := json.Unmarshal([]byte(document), &policy); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("failed to parse policy document: %s", err))
+		return
+	}
+
+	if policy.Name == "" {
+		resp.Error = function.NewArgumentFuncError(0, "policy document has no name")
+		return
+	}
+
+	switch policy.Type {
+	case "security", "license", "operational_risk":
+	default:
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("unsupported policy type '%s', must be one of security, license or operational_risk", policy.Type))
+		return
+	}
+
+	var model xray_resource.PolicyResourceModel
+	diags := model.FromAPIModel(ctx, policy)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	result, diags := types.ObjectValue(
+		policyFromJSONAttributeTypes(model.Rules.Type(ctx)),
+		map[string]attr.Value{
+			"name":        model.Name,
+			"type":        model.Type,
+			"description": model.Description,
+			"rules":       model.Rules,
+		},
+	)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.DynamicValue(result)))
+}
@@ -0,0 +1,173 @@
+package functions_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/xray/functions"
+)
+
+func runPolicyFromJSON(t *testing.T, document string) (map[string]attr.Value, *function.FuncError) {
+	ctx := context.Background()
+	f := functions.NewPolicyFromJSONFunction()
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.DynamicUnknown()),
+	}
+	f.Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(document)}),
+	}, &resp)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	policy, ok := resp.Result.Value().(types.Dynamic).UnderlyingValue().(types.Object)
+	if !ok {
+		t.Fatalf("expected an object, got %s", resp.Result.Value())
+	}
+
+	return policy.Attributes(), nil
+}
+
+func ruleAttributes(policy map[string]attr.Value) []map[string]attr.Value {
+	var rules []map[string]attr.Value
+	for _, rule := range policy["rules"].(types.List).Elements() {
+		rules = append(rules, rule.(types.Object).Attributes())
+	}
+	return rules
+}
+
+func criteriaAttributes(t *testing.T, rule map[string]attr.Value) map[string]attr.Value {
+	criteria := rule["criteria"].(types.Set).Elements()
+	if len(criteria) != 1 {
+		t.Fatalf("expected 1 criteria, got %d", len(criteria))
+	}
+	return criteria[0].(types.Object).Attributes()
+}
+
+func TestPolicyFromJSON_security(t *testing.T) {
+	policy, err := runPolicyFromJSON(t, `{
+		"name": "exported-security-policy",
+		"type": "security",
+		"description": "Exported from another Xray instance",
+		"rules": [
+			{
+				"name": "critical",
+				"priority": 2,
+				"criteria": {"min_severity": "Critical"},
+				"actions": {"fail_build": true, "block_download": {"unscanned": false, "active": false}}
+			},
+			{
+				"name": "cvss",
+				"priority": 1,
+				"criteria": {"cvss_range": {"from": 7, "to": 10}},
+				"actions": {"block_release_bundle_distribution": true, "block_download": {"unscanned": false, "active": false}}
+			}
+		]
+	}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if policy["name"].(types.String).ValueString() != "exported-security-policy" {
+		t.Errorf("unexpected name: %s", policy["name"])
+	}
+	if policy["type"].(types.String).ValueString() != "security" {
+		t.Errorf("unexpected type: %s", policy["type"])
+	}
+	if policy["description"].(types.String).ValueString() != "Exported from another Xray instance" {
+		t.Errorf("unexpected description: %s", policy["description"])
+	}
+
+	rules := ruleAttributes(policy)
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+
+	if rules[0]["name"].(types.String).ValueString() != "cvss" || rules[0]["priority"].(types.Int64).ValueInt64() != 1 {
+		t.Errorf("expected rule 'cvss' with priority 1 first, got %s %s", rules[0]["name"], rules[0]["priority"])
+	}
+	if rules[1]["name"].(types.String).ValueString() != "critical" || rules[1]["priority"].(types.Int64).ValueInt64() != 2 {
+		t.Errorf("expected rule 'critical' with priority 2 second, got %s %s", rules[1]["name"], rules[1]["priority"])
+	}
+
+	if severity := criteriaAttributes(t, rules[1])["min_severity"].(types.String).ValueString(); severity != "Critical" {
+		t.Errorf("expected min_severity Critical, got %s", severity)
+	}
+	if cvssRange := criteriaAttributes(t, rules[0])["cvss_range"].(types.List).Elements(); len(cvssRange) != 1 {
+		t.Errorf("expected 1 cvss_range, got %d", len(cvssRange))
+	}
+}
+
+func TestPolicyFromJSON_license(t *testing.T) {
+	policy, err := runPolicyFromJSON(t, `{
+		"name": "exported-license-policy",
+		"type": "license",
+		"rules": [
+			{
+				"name": "banned",
+				"priority": 1,
+				"criteria": {"banned_licenses": ["0BSD", "AAL"], "allow_unknown": false},
+				"actions": {"custom_severity": "Medium", "block_download": {"unscanned": false, "active": false}}
+			}
+		]
+	}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if policy["type"].(types.String).ValueString() != "license" {
+		t.Errorf("unexpected type: %s", policy["type"])
+	}
+
+	rules := ruleAttributes(policy)
+	if len(rules) != 1 {
+		t.Fatalf("expected 1 rule, got %d", len(rules))
+	}
+
+	criteria := criteriaAttributes(t, rules[0])
+	if licenses := criteria["banned_licenses"].(types.List).Elements(); len(licenses) != 2 {
+		t.Errorf("expected 2 banned licenses, got %d", len(licenses))
+	}
+	if criteria["allow_unknown"].(types.Bool).ValueBool() {
+		t.Error("expected allow_unknown to be false")
+	}
+}
+
+func TestPolicyFromJSON_invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		document      string
+		expectedError string
+	}{
+		{
+			name:          "not_json",
+			document:      "not json",
+			expectedError: "failed to parse policy document",
+		},
+		{
+			name:          "no_name",
+			document:      `{"type": "security", "rules": []}`,
+			expectedError: "policy document has no name",
+		},
+		{
+			name:          "unsupported_type",
+			document:      `{"name": "exported-policy", "type": "malicious", "rules": []}`,
+			expectedError: "unsupported policy type 'malicious'",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := runPolicyFromJSON(t, tc.document)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Text, tc.expectedError) {
+				t.Errorf("expected error to contain '%s', got '%s'", tc.expectedError, err.Text)
+			}
+		})
+	}
+}
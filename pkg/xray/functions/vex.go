@@ -0,0 +1,362 @@
+package functions
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const vexStatusNotAffected = "not_affected"
+
+// vexIgnoreRule is an ignore rule for a vulnerability which a VEX document
+// states the component is not affected by.
+type vexIgnoreRule struct {
+	CVE              string
+	Notes            string
+	ComponentName    string
+	ComponentVersion string
+}
+
+// parseVEX parses an OpenVEX or CycloneDX VEX JSON document and returns an
+// ignore rule for every component stated as not affected by a vulnerability.
+func parseVEX(document []byte) ([]vexIgnoreRule, error) {
+	var probe struct {
+		Context    string          `json:"@context"`
+		BOMFormat  string          `json:"bomFormat"`
+		Statements json.RawMessage `json:"statements"`
+	}
+	if err := json.Unmarshal(document, &probe); err != nil {
+		return nil, fmt.Errorf("failed to parse VEX document: %w", err)
+	}
+
+	switch {
+	case probe.BOMFormat == "CycloneDX":
+		return parseCycloneDXVEX(document)
+	case strings.Contains(probe.Context, "openvex") || probe.Statements != nil:
+		return parseOpenVEX(document)
+	default:
+		return nil, fmt.Errorf("unsupported VEX document, expected an OpenVEX document or a CycloneDX document with 'bomFormat' set to 'CycloneDX'")
+	}
+}
+
+type openVEXDocument struct {
+	Statements []openVEXStatement `json:"statements"`
+}
+
+type openVEXStatement struct {
+	Vulnerability   openVEXVulnerability `json:"vulnerability"`
+	Products        []openVEXComponent   `json:"products"`
+	Subcomponents   []openVEXComponent   `json:"subcomponents"`
+	Status          string               `json:"status"`
+	Justification   string               `json:"justification"`
+	ImpactStatement string               `json:"impact_statement"`
+	StatusNotes     string               `json:"status_notes"`
+}
+
+// openVEXVulnerability is a string before OpenVEX v0.2.0 and an object since.
+type openVEXVulnerability struct {
+	Name string `json:"name"`
+}
+
+func (v *openVEXVulnerability) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &v.Name); err == nil {
+		return nil
+	}
+
+	type vulnerability openVEXVulnerability
+	return json.Unmarshal(data, (*vulnerability)(v))
+}
+
+// openVEXComponent is a string before OpenVEX v0.2.0 and an object since.
+type openVEXComponent struct {
+	ID            string             `json:"@id"`
+	Identifiers   map[string]string  `json:"identifiers"`
+	Subcomponents []openVEXComponent `json:"subcomponents"`
+}
+
+func (c *openVEXComponent) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &c.ID); err == nil {
+		return nil
+	}
+
+	type component openVEXComponent
+	return json.Unmarshal(data, (*component)(c))
+}
+
+func (c openVEXComponent) purl() string {
+	if purl, ok := c.Identifiers["purl"]; ok {
+		return purl
+	}
+
+	return c.ID
+}
+
+func parseOpenVEX(document []byte) ([]vexIgnoreRule, error) {
+	var vex openVEXDocument
+	if err := json.Unmarshal(document, &vex); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenVEX document: %w", err)
+	}
+
+	var rules vexIgnoreRules
+	for idx, statement := range vex.Statements {
+		if statement.Status != vexStatusNotAffected {
+			continue
+		}
+
+		if statement.Vulnerability.Name == "" {
+			return nil, fmt.Errorf("statement %d has no vulnerability name", idx)
+		}
+
+		// The subcomponents are the vulnerable components of a product, the
+		// product itself is only used when no subcomponent is listed.
+		var components []openVEXComponent
+		components = append(components, statement.Subcomponents...)
+		for _, product := range statement.Products {
+			if len(product.Subcomponents) > 0 {
+				components = append(components, product.Subcomponents...)
+			} else if len(statement.Subcomponents) == 0 {
+				components = append(components, product)
+			}
+		}
+
+		if len(components) == 0 {
+			return nil, fmt.Errorf("statement %d for %s has no product", idx, statement.Vulnerability.Name)
+		}
+
+		notes := vexNotes(statement.Justification, statement.ImpactStatement, statement.StatusNotes)
+		for _, component := range components {
+			name, version, err := xrayComponent(component.purl())
+			if err != nil {
+				return nil, fmt.Errorf("statement %d for %s: %w", idx, statement.Vulnerability.Name, err)
+			}
+
+			rules.add(vexIgnoreRule{
+				CVE:              statement.Vulnerability.Name,
+				Notes:            notes,
+				ComponentName:    name,
+				ComponentVersion: version,
+			})
+		}
+	}
+
+	return rules.rules, nil
+}
+
+type cycloneDXDocument struct {
+	Metadata struct {
+		Component *cycloneDXComponent `json:"component"`
+	} `json:"metadata"`
+	Components      []cycloneDXComponent     `json:"components"`
+	Vulnerabilities []cycloneDXVulnerability `json:"vulnerabilities"`
+}
+
+type cycloneDXComponent struct {
+	BOMRef     string               `json:"bom-ref"`
+	Group      string               `json:"group"`
+	Name       string               `json:"name"`
+	Version    string               `json:"version"`
+	PURL       string               `json:"purl"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXVulnerability struct {
+	ID       string `json:"id"`
+	Analysis struct {
+		State         string `json:"state"`
+		Justification string `json:"justification"`
+		Detail        string `json:"detail"`
+	} `json:"analysis"`
+	Affects []struct {
+		Ref string `json:"ref"`
+	} `json:"affects"`
+}
+
+func indexCycloneDXComponents(index map[string]cycloneDXComponent, components []cycloneDXComponent) {
+	for _, component := range components {
+		if component.BOMRef != "" {
+			index[component.BOMRef] = component
+		}
+		indexCycloneDXComponents(index, component.Components)
+	}
+}
+
+func parseCycloneDXVEX(document []byte) ([]vexIgnoreRule, error) {
+	var bom cycloneDXDocument
+	if err := json.Unmarshal(document, &bom); err != nil {
+		return nil, fmt.Errorf("failed to parse CycloneDX document: %w", err)
+	}
+
+	components := map[string]cycloneDXComponent{}
+	if bom.Metadata.Component != nil {
+		indexCycloneDXComponents(components, []cycloneDXComponent{*bom.Metadata.Component})
+	}
+	indexCycloneDXComponents(components, bom.Components)
+
+	var rules vexIgnoreRules
+	for idx, vulnerability := range bom.Vulnerabilities {
+		if vulnerability.Analysis.State != vexStatusNotAffected {
+			continue
+		}
+
+		if vulnerability.ID == "" {
+			return nil, fmt.Errorf("vulnerability %d has no id", idx)
+		}
+
+		if len(vulnerability.Affects) == 0 {
+			return nil, fmt.Errorf("vulnerability %s has no affected component", vulnerability.ID)
+		}
+
+		notes := vexNotes(vulnerability.Analysis.Justification, vulnerability.Analysis.Detail)
+		for _, affect := range vulnerability.Affects {
+			name, version, err := resolveCycloneDXRef(components, affect.Ref)
+			if err != nil {
+				return nil, fmt.Errorf("vulnerability %s: %w", vulnerability.ID, err)
+			}
+
+			rules.add(vexIgnoreRule{
+				CVE:              vulnerability.ID,
+				Notes:            notes,
+				ComponentName:    name,
+				ComponentVersion: version,
+			})
+		}
+	}
+
+	return rules.rules, nil
+}
+
+// resolveCycloneDXRef resolves a reference to a component of this BOM, a
+// BOM-Link to a component of another BOM or a package URL.
+func resolveCycloneDXRef(components map[string]cycloneDXComponent, ref string) (string, string, error) {
+	if strings.HasPrefix(ref, "pkg:") {
+		return xrayComponent(ref)
+	}
+
+	bomRef := ref
+	if strings.HasPrefix(ref, "urn:cdx:") {
+		_, bomRef, _ = strings.Cut(ref, "#")
+		if unescaped, err := url.QueryUnescape(bomRef); err == nil {
+			bomRef = unescaped
+		}
+	}
+
+	component, ok := components[bomRef]
+	if !ok {
+		return "", "", fmt.Errorf("unknown component reference '%s'", ref)
+	}
+
+	if component.PURL != "" {
+		return xrayComponent(component.PURL)
+	}
+
+	name := component.Name
+	if component.Group != "" {
+		name = component.Group + ":" + component.Name
+	}
+
+	return name, component.Version, nil
+}
+
+// vexNotes builds the ignore rule notes from the justification and the
+// statements of a VEX entry.
+func vexNotes(justification string, statements ...string) string {
+	notes := "Not affected"
+	if justification != "" {
+		notes = fmt.Sprintf("%s (%s)", notes, justification)
+	}
+
+	for _, statement := range statements {
+		if statement != "" {
+			notes = fmt.Sprintf("%s: %s", notes, statement)
+			break
+		}
+	}
+
+	return notes
+}
+
+// vexIgnoreRules collects ignore rules in the order of the document, without
+// duplicates.
+type vexIgnoreRules struct {
+	rules []vexIgnoreRule
+	seen  map[vexIgnoreRule]bool
+}
+
+func (r *vexIgnoreRules) add(rule vexIgnoreRule) {
+	if r.seen == nil {
+		r.seen = map[vexIgnoreRule]bool{}
+	}
+
+	if r.seen[rule] {
+		return
+	}
+	r.seen[rule] = true
+	r.rules = append(r.rules, rule)
+}
+
+// purlTypeXrayPrefixes maps package URL types to the prefix of the Xray
+// component IDs, when they differ.
+var purlTypeXrayPrefixes = map[string]string{
+	"maven":  "gav",
+	"golang": "go",
+	"gem":    "rubygems",
+	"apk":    "alpine",
+	"oci":    "docker",
+}
+
+// xrayComponent converts a package URL, e.g.
+// 'pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1', to the name and
+// version of an Xray component, e.g.
+// 'gav://org.apache.logging.log4j:log4j-core' and '2.14.1'. Values which are
+// not package URLs are used as the component name as is.
+func xrayComponent(purl string) (string, string, error) {
+	if purl == "" {
+		return "", "", fmt.Errorf("component has no identifier")
+	}
+
+	if !strings.HasPrefix(purl, "pkg:") {
+		return purl, "", nil
+	}
+
+	s := strings.TrimPrefix(purl, "pkg:")
+	s, _, _ = strings.Cut(s, "#")
+	s, _, _ = strings.Cut(s, "?")
+
+	purlType, rest, ok := strings.Cut(strings.TrimLeft(s, "/"), "/")
+	if !ok || rest == "" {
+		return "", "", fmt.Errorf("invalid package URL '%s'", purl)
+	}
+	purlType = strings.ToLower(purlType)
+
+	var version string
+	if idx := strings.LastIndex(rest, "@"); idx >= 0 {
+		v, err := url.PathUnescape(rest[idx+1:])
+		if err != nil {
+			return "", "", fmt.Errorf("invalid package URL '%s': %w", purl, err)
+		}
+		version = v
+		rest = rest[:idx]
+	}
+
+	segments := strings.Split(strings.Trim(rest, "/"), "/")
+	for idx, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid package URL '%s': %w", purl, err)
+		}
+		segments[idx] = unescaped
+	}
+
+	prefix, ok := purlTypeXrayPrefixes[purlType]
+	if !ok {
+		prefix = purlType
+	}
+
+	name := strings.Join(segments, "/")
+	if purlType == "maven" && len(segments) > 1 {
+		name = strings.Join(segments[:len(segments)-1], ".") + ":" + segments[len(segments)-1]
+	}
+
+	return fmt.Sprintf("%s://%s", prefix, name), version, nil
+}
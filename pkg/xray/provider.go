@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +16,7 @@ import (
 	"github.com/jfrog/terraform-provider-shared/util"
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
 	xray_datasource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/datasource"
+	xray_functions "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/functions"
 	xray_resource "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
 )
 
@@ -23,6 +25,7 @@ var productId = "terraform-provider-xray/" + Version
 
 // Ensure the implementation satisfies the provider.Provider interface.
 var _ provider.Provider = &XrayProvider{}
+var _ provider.ProviderWithFunctions = &XrayProvider{}
 
 type XrayProvider struct {
 	Meta util.ProviderMetadata
@@ -188,17 +191,23 @@ func (p *XrayProvider) Resources(ctx context.Context) []func() resource.Resource
 		xray_resource.NewBinaryManagerReposResource,
 		xray_resource.NewBinaryManagerReleaseBundlesV2Resource,
 		xray_resource.NewCustomIssueResource,
+		xray_resource.NewExposuresReportResource,
+		xray_resource.NewGenericPolicyResource,
 		xray_resource.NewIgnoreRuleResource,
+		xray_resource.NewIgnoreRulesSetResource,
 		xray_resource.NewLicensePolicyResource,
 		xray_resource.NewLicensesReportResource,
 		xray_resource.NewOperationalRiskPolicyResource,
 		xray_resource.NewOperationalRisksReportResource,
+		xray_resource.NewReportExportResource,
 		xray_resource.NewRepositoryConfigResource,
 		xray_resource.NewSecurityPolicyResource,
 		xray_resource.NewSettingsResource,
 		xray_resource.NewViolationsReportResource,
 		xray_resource.NewVulnerabilitiesReportResource,
 		xray_resource.NewWatchResource,
+		xray_resource.NewWatchHistoricalScanResource,
+		xray_resource.NewWatchPolicyAssignmentResource,
 		xray_resource.NewWebhookResource,
 		xray_resource.NewWorkersCountResource,
 	}
@@ -208,6 +217,21 @@ func (p *XrayProvider) Resources(ctx context.Context) []func() resource.Resource
 func (p *XrayProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		xray_datasource.NewArtifactsScanDataSource,
+		xray_datasource.NewIgnoreRulesDataSource,
+		xray_datasource.NewPoliciesDataSource,
+		xray_datasource.NewPolicyDataSource,
+		xray_datasource.NewReportsDataSource,
+		xray_datasource.NewViolationsDataSource,
+		xray_datasource.NewWatchDataSource,
+		xray_datasource.NewWatchesDataSource,
+	}
+}
+
+// Functions satisfies the provider.ProviderWithFunctions interface for XrayProvider.
+func (p *XrayProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		xray_functions.NewIgnoreRulesFromVEXFunction,
+		xray_functions.NewPolicyFromJSONFunction,
 	}
 }
 
@@ -1,11 +1,17 @@
 package xray
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -16,9 +22,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	"github.com/samber/lo"
@@ -55,7 +61,9 @@ type PolicyResource struct {
 	TypeName     string
 }
 
-type PolicyResourceModel struct {
+// policyResourceModelV1 is the model of the schema version 1, where the rules
+// are a set.
+type policyResourceModelV1 struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
@@ -67,6 +75,18 @@ type PolicyResourceModel struct {
 	Modified    types.String `tfsdk:"modified"`
 }
 
+type PolicyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	ProjectKey  types.String `tfsdk:"project_key"`
+	Type        types.String `tfsdk:"type"`
+	Rules       types.List   `tfsdk:"rule"`
+	Author      types.String `tfsdk:"author"`
+	Created     types.String `tfsdk:"created"`
+	Modified    types.String `tfsdk:"modified"`
+}
+
 var toActionsAPIModel = func(ctx context.Context, actionsElems []attr.Value) (PolicyRuleActionsAPIModel, diag.Diagnostics) {
 	diags := diag.Diagnostics{}
 
@@ -120,7 +140,7 @@ func (m PolicyResourceModel) toAPIModel(
 
 	rules := lo.Map(
 		m.Rules.Elements(),
-		func(elem attr.Value, _ int) PolicyRuleAPIModel {
+		func(elem attr.Value, index int) PolicyRuleAPIModel {
 			attrs := elem.(types.Object).Attributes()
 
 			criteria, ds := toCriteriaAPIModel(ctx, attrs["criteria"].(types.Set).Elements())
@@ -135,7 +155,7 @@ func (m PolicyResourceModel) toAPIModel(
 
 			return PolicyRuleAPIModel{
 				Name:     attrs["name"].(types.String).ValueString(),
-				Priority: attrs["priority"].(types.Int64).ValueInt64(),
+				Priority: rulePriority(attrs["priority"].(types.Int64), index),
 				Criteria: criteria,
 				Actions:  actions,
 			}
@@ -249,22 +269,27 @@ func (m *PolicyResourceModel) fromAPIModel(
 	diags := diag.Diagnostics{}
 
 	var ruleAttrTypes map[string]attr.Type
-	var ruleSetElementType types.ObjectType
+	var ruleElementType types.ObjectType
 
 	switch apiModel.Type {
 	case "license":
 		ruleAttrTypes = licenseRuleAttrTypes
-		ruleSetElementType = licenseRuleSetElementType
+		ruleElementType = licenseRuleElementType
 	case "security":
 		ruleAttrTypes = securityRuleAttrTypes
-		ruleSetElementType = securityRuleSetElementType
+		ruleElementType = securityRuleElementType
 	case "operational_risk":
 		ruleAttrTypes = opRiskRuleAttrTypes
-		ruleSetElementType = opRiskRuleSetElementType
+		ruleElementType = opRiskRuleElementType
+	}
+
+	var apiRules []PolicyRuleAPIModel
+	if apiModel.Rules != nil {
+		apiRules = m.orderRules(*apiModel.Rules)
 	}
 
 	rules := lo.Map(
-		*apiModel.Rules,
+		apiRules,
 		func(rule PolicyRuleAPIModel, _ int) attr.Value {
 			criteriaSet, d := fromCriteriaAPIModel(ctx, rule.Criteria)
 			if d.HasError() {
@@ -293,8 +318,8 @@ func (m *PolicyResourceModel) fromAPIModel(
 		},
 	)
 
-	rulesSet, d := types.SetValue(
-		ruleSetElementType,
+	rulesList, d := types.ListValue(
+		ruleElementType,
 		rules,
 	)
 	if d.HasError() {
@@ -309,11 +334,72 @@ func (m *PolicyResourceModel) fromAPIModel(
 	m.Created = types.StringValue(apiModel.Created)
 	m.Modified = types.StringValue(apiModel.Modified)
 
-	m.Rules = rulesSet
+	m.Rules = rulesList
 
 	return diags
 }
 
+// orderRules sorts the rules by priority, keeping the rules already in the
+// model in their order, so explicit priorities which don't follow the
+// position of the rules don't show up as a diff.
+func (m PolicyResourceModel) orderRules(rules []PolicyRuleAPIModel) []PolicyRuleAPIModel {
+	ordered := slices.Clone(rules)
+	slices.SortStableFunc(ordered, func(a, b PolicyRuleAPIModel) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	if m.Rules.IsNull() || m.Rules.IsUnknown() {
+		return ordered
+	}
+
+	positions := map[string]int{}
+	for index, elem := range m.Rules.Elements() {
+		name, ok := elem.(types.Object).Attributes()["name"].(types.String)
+		if ok && !name.IsUnknown() && !name.IsNull() {
+			if _, found := positions[name.ValueString()]; !found {
+				positions[name.ValueString()] = index
+			}
+		}
+	}
+
+	slices.SortStableFunc(ordered, func(a, b PolicyRuleAPIModel) int {
+		positionA, knownA := positions[a.Name]
+		positionB, knownB := positions[b.Name]
+		switch {
+		case knownA && knownB:
+			return cmp.Compare(positionA, positionB)
+		case knownA:
+			return -1
+		case knownB:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return ordered
+}
+
+// FromAPIModel maps the policy into the model using the criteria and actions
+// mapping of the policy resource matching the policy type.
+func (m *PolicyResourceModel) FromAPIModel(ctx context.Context, apiModel PolicyAPIModel) diag.Diagnostics {
+	switch apiModel.Type {
+	case "security":
+		return SecurityPolicyResource{}.fromAPIModel(ctx, apiModel, m)
+	case "license":
+		return LicensePolicyResource{}.fromAPIModel(ctx, apiModel, m)
+	case "operational_risk":
+		return OperationalRiskPolicyResource{}.fromAPIModel(ctx, apiModel, m)
+	}
+
+	return diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"invalid policy type",
+			fmt.Sprintf("policy '%s' has unsupported type '%s'", apiModel.Name, apiModel.Type),
+		),
+	}
+}
+
 var commonActionsBlocks = map[string]schema.Block{
 	"block_download": schema.SetNestedBlock{
 		NestedObject: schema.NestedBlockObject{
@@ -415,10 +501,7 @@ var policySchemaAttrs = lo.Assign(
 			Validators: []validator.String{
 				stringvalidator.LengthAtLeast(1),
 			},
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
-			},
-			Description: "Name of the policy (must be unique)",
+			Description: "Name of the policy (must be unique). Renaming the policy creates the policy under the new name, assigns it to the watches of the policy in place of the previous name, then deletes the previous policy. The policy can't be renamed and change its `project_key` in the same apply.",
 		},
 		"description": schema.StringAttribute{
 			Optional:    true,
@@ -446,60 +529,320 @@ var policySchemaAttrs = lo.Assign(
 	},
 )
 
-var policyBlocks = func(criteriaAttrs map[string]schema.Attribute, criteriaBlocks map[string]schema.Block, actionsAttrs map[string]schema.Attribute, actionsBlocks map[string]schema.Block) map[string]schema.Block {
-	return map[string]schema.Block{
-		"rule": schema.SetNestedBlock{
-			NestedObject: schema.NestedBlockObject{
-				Attributes: map[string]schema.Attribute{
-					"name": schema.StringAttribute{
-						Required: true,
-						Validators: []validator.String{
-							stringvalidator.LengthAtLeast(1),
-						},
-						Description: "Name of the rule",
-					},
-					"priority": schema.Int64Attribute{
-						Required: true,
-						Validators: []validator.Int64{
-							int64validator.AtLeast(1),
-						},
-						Description: "Integer describing the rule priority. Must be at least 1",
-					},
+// policyRuleNestedObject returns the rule of the policy, with the criteria and
+// actions of the policy type.
+func policyRuleNestedObject(priority schema.Int64Attribute, criteriaAttrs map[string]schema.Attribute, criteriaBlocks map[string]schema.Block, actionsAttrs map[string]schema.Attribute, actionsBlocks map[string]schema.Block) schema.NestedBlockObject {
+	return schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				Description: "Name of the rule",
+			},
+			"priority": priority,
+		},
+		Blocks: map[string]schema.Block{
+			"criteria": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: criteriaAttrs,
+					Blocks:     criteriaBlocks,
+				},
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeBetween(1, 1),
+				},
+				Description: "The set of security conditions to examine when an scanned artifact is scanned.",
+			},
+			"actions": schema.SetNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: actionsAttrs,
+					Blocks:     actionsBlocks,
 				},
-				Blocks: map[string]schema.Block{
-					"criteria": schema.SetNestedBlock{
-						NestedObject: schema.NestedBlockObject{
-							Attributes: criteriaAttrs,
-							Blocks:     criteriaBlocks,
-						},
-						Validators: []validator.Set{
-							setvalidator.IsRequired(),
-							setvalidator.SizeBetween(1, 1),
-						},
-						Description: "The set of security conditions to examine when an scanned artifact is scanned.",
-					},
-					"actions": schema.SetNestedBlock{
-						NestedObject: schema.NestedBlockObject{
-							Attributes: actionsAttrs,
-							Blocks:     actionsBlocks,
-						},
-						Validators: []validator.Set{
-							setvalidator.IsRequired(),
-							setvalidator.SizeBetween(1, 1),
-						},
-						Description: "Specifies the actions to take once a security policy violation has been triggered.",
-					},
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeBetween(1, 1),
 				},
+				Description: "Specifies the actions to take once a security policy violation has been triggered.",
 			},
+		},
+	}
+}
+
+const policyRulesDescription = "A list of user-defined rules allowing you to trigger violations for specific vulnerability or license breaches by setting a license or security criteria, with a corresponding set of automatic actions according to your needs. Rules are processed according to the ascending order in which they are placed in the Rules list on the Policy. If a rule is met, the subsequent rules in the list will not be applied."
+
+var policyBlocks = func(criteriaAttrs map[string]schema.Attribute, criteriaBlocks map[string]schema.Block, actionsAttrs map[string]schema.Attribute, actionsBlocks map[string]schema.Block) map[string]schema.Block {
+	priority := schema.Int64Attribute{
+		Optional: true,
+		Computed: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			rulePriorityFromPosition(),
+		},
+		Description: "Integer describing the rule priority. Must be at least 1 and unique within the policy. Defaults to the position of the rule in the list, starting at 1.",
+	}
+
+	return map[string]schema.Block{
+		"rule": schema.ListNestedBlock{
+			NestedObject: policyRuleNestedObject(priority, criteriaAttrs, criteriaBlocks, actionsAttrs, actionsBlocks),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+				uniqueRulePriorities(),
+			},
+			Description: policyRulesDescription,
+		},
+	}
+}
+
+// policyBlocksV1 returns the rules of the schema version 1, where the rules
+// are a set with a required priority.
+var policyBlocksV1 = func(criteriaAttrs map[string]schema.Attribute, criteriaBlocks map[string]schema.Block, actionsAttrs map[string]schema.Attribute, actionsBlocks map[string]schema.Block) map[string]schema.Block {
+	priority := schema.Int64Attribute{
+		Required: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		Description: "Integer describing the rule priority. Must be at least 1",
+	}
+
+	return map[string]schema.Block{
+		"rule": schema.SetNestedBlock{
+			NestedObject: policyRuleNestedObject(priority, criteriaAttrs, criteriaBlocks, actionsAttrs, actionsBlocks),
 			Validators: []validator.Set{
 				setvalidator.IsRequired(),
 				setvalidator.SizeAtLeast(1),
 			},
-			Description: "A list of user-defined rules allowing you to trigger violations for specific vulnerability or license breaches by setting a license or security criteria, with a corresponding set of automatic actions according to your needs. Rules are processed according to the ascending order in which they are placed in the Rules list on the Policy. If a rule is met, the subsequent rules in the list will not be applied.",
+			Description: policyRulesDescription,
+		},
+	}
+}
+
+// rulePriority returns the priority of the rule at the index of the list,
+// which defaults to its position.
+func rulePriority(priority types.Int64, index int) int64 {
+	if priority.IsNull() || priority.IsUnknown() {
+		return int64(index + 1)
+	}
+
+	return priority.ValueInt64()
+}
+
+type rulePriorityFromPositionModifier struct{}
+
+// Description returns a plain text description of the modifier's behavior.
+func (m rulePriorityFromPositionModifier) Description(ctx context.Context) string {
+	return "Defaults to the position of the rule in the list, starting at 1."
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior.
+func (m rulePriorityFromPositionModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyInt64 sets the priority not set in the configuration to the
+// position of the rule.
+func (m rulePriorityFromPositionModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	step, _ := req.Path.ParentPath().Steps().LastStep()
+	index, ok := step.(path.PathStepElementKeyInt)
+	if !ok {
+		return
+	}
+
+	resp.PlanValue = types.Int64Value(int64(index) + 1)
+}
+
+func rulePriorityFromPosition() planmodifier.Int64 {
+	return rulePriorityFromPositionModifier{}
+}
+
+type uniqueRulePrioritiesValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v uniqueRulePrioritiesValidator) Description(ctx context.Context) string {
+	return "rule priorities must be unique"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v uniqueRulePrioritiesValidator) MarkdownDescription(ctx context.Context) string {
+	return "rule priorities must be unique"
+}
+
+// ValidateList checks that the priorities of the rules, explicit or derived
+// from their position, are unique.
+func (v uniqueRulePrioritiesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	positions := map[int64]int{}
+	for index, elem := range req.ConfigValue.Elements() {
+		rule, ok := elem.(types.Object)
+		if !ok || rule.IsNull() || rule.IsUnknown() {
+			continue
+		}
+
+		priorityValue, ok := rule.Attributes()["priority"].(types.Int64)
+		if !ok || priorityValue.IsUnknown() {
+			continue
+		}
+
+		priority := rulePriority(priorityValue, index)
+		if position, found := positions[priority]; found {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(index).AtName("priority"),
+				"Duplicate Rule Priority",
+				fmt.Sprintf("Priority %d is already used by the rule at position %d. Rule priorities must be unique, and default to the position of the rule starting at 1.", priority, position+1),
+			)
+			continue
+		}
+
+		positions[priority] = index
+	}
+}
+
+func uniqueRulePriorities() validator.List {
+	return uniqueRulePrioritiesValidator{}
+}
+
+// policyStateUpgraders upgrades the state of the policy resources with the
+// rules of the schema version 1 to rules of the current element type.
+func policyStateUpgraders(blocksV1 map[string]schema.Block, ruleElementType types.ObjectType) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// State upgrade implementation from 1 (prior state version) to 2 (Schema.Version)
+		1: {
+			PriorSchema: &schema.Schema{
+				Attributes: policySchemaAttrs,
+				Blocks:     blocksV1,
+			},
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var priorStateData policyResourceModelV1
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedStateData := PolicyResourceModel{
+					ID:          priorStateData.ID,
+					Name:        priorStateData.Name,
+					Description: priorStateData.Description,
+					ProjectKey:  priorStateData.ProjectKey,
+					Type:        priorStateData.Type,
+					Author:      priorStateData.Author,
+					Created:     priorStateData.Created,
+					Modified:    priorStateData.Modified,
+				}
+
+				if priorStateData.Rules.IsNull() {
+					upgradedStateData.Rules = types.ListNull(ruleElementType)
+				} else {
+					rules := make([]attr.Value, 0, len(priorStateData.Rules.Elements()))
+					for _, rule := range priorStateData.Rules.Elements() {
+						upgradedRule, d := upgradeValue(ctx, rule, ruleElementType)
+						if d.HasError() {
+							resp.Diagnostics.Append(d...)
+							return
+						}
+
+						rules = append(rules, upgradedRule)
+					}
+
+					// The rules are ordered by priority, as Xray processes them.
+					slices.SortStableFunc(rules, func(a, b attr.Value) int {
+						return cmp.Compare(
+							a.(types.Object).Attributes()["priority"].(types.Int64).ValueInt64(),
+							b.(types.Object).Attributes()["priority"].(types.Int64).ValueInt64(),
+						)
+					})
+
+					rulesList, d := types.ListValue(ruleElementType, rules)
+					if d != nil {
+						resp.Diagnostics.Append(d...)
+					}
+
+					upgradedStateData.Rules = rulesList
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+			},
 		},
 	}
 }
 
+// upgradeValue converts the value of the prior state to the type of the
+// current schema, with the attributes added since as null.
+func upgradeValue(ctx context.Context, value attr.Value, target attr.Type) (attr.Value, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	if value.IsNull() || value.IsUnknown() {
+		nullValue, err := target.ValueFromTerraform(ctx, tftypes.NewValue(target.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddError("failed to upgrade state", err.Error())
+		}
+
+		return nullValue, diags
+	}
+
+	switch targetType := target.(type) {
+	case types.ObjectType:
+		priorAttrs := value.(types.Object).Attributes()
+
+		attrs := make(map[string]attr.Value, len(targetType.AttrTypes))
+		for name, attrType := range targetType.AttrTypes {
+			priorAttr, ok := priorAttrs[name]
+			if !ok {
+				priorAttr = types.DynamicNull()
+			}
+
+			upgradedAttr, d := upgradeValue(ctx, priorAttr, attrType)
+			diags.Append(d...)
+			attrs[name] = upgradedAttr
+		}
+
+		object, d := types.ObjectValue(targetType.AttrTypes, attrs)
+		diags.Append(d...)
+		return object, diags
+	case types.SetType:
+		elems, d := upgradeElements(ctx, value.(types.Set).Elements(), targetType.ElemType)
+		diags.Append(d...)
+
+		set, d := types.SetValue(targetType.ElemType, elems)
+		diags.Append(d...)
+		return set, diags
+	case types.ListType:
+		elems, d := upgradeElements(ctx, value.(types.List).Elements(), targetType.ElemType)
+		diags.Append(d...)
+
+		list, d := types.ListValue(targetType.ElemType, elems)
+		diags.Append(d...)
+		return list, diags
+	}
+
+	return value, diags
+}
+
+func upgradeElements(ctx context.Context, elems []attr.Value, elemType attr.Type) ([]attr.Value, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	upgradedElems := make([]attr.Value, 0, len(elems))
+	for _, elem := range elems {
+		upgradedElem, d := upgradeValue(ctx, elem, elemType)
+		diags.Append(d...)
+		upgradedElems = append(upgradedElems, upgradedElem)
+	}
+
+	return upgradedElems, diags
+}
+
 type PolicyCVSSRangeAPIModel struct {
 	To   *float64 `json:"to,omitempty"`
 	From *float64 `json:"from,omitempty"`
@@ -524,6 +867,12 @@ type OperationalRiskCriteriaAPIModel struct {
 	Risk                          string `json:"risk,omitempty"`
 }
 
+type PolicyPackageAPIModel struct {
+	Type     string
+	Name     string
+	Versions []string
+}
+
 type PolicyRuleCriteriaAPIModel struct {
 	// Security Criteria
 	MinimumSeverity string                   `json:"min_severity,omitempty"` // Omitempty is used because the empty field is conflicting with CVSSRange
@@ -537,6 +886,9 @@ type PolicyRuleCriteriaAPIModel struct {
 	PackageName         string                   `json:"package_name,omitempty"`
 	PackageType         string                   `json:"package_type,omitempty"`
 	PackageVersions     []string                 `json:"package_versions,omitempty"`
+	// Packages are the `packages` of the rule, which are expanded to one rule
+	// per package before sending the policy to Xray.
+	Packages []PolicyPackageAPIModel `json:"-"`
 	// We use pointer for CVSSRange to address nil-verification for non-primitive types.
 	// Unlike primitive types, when the non-primitive type in the struct is set
 	// to nil, the empty key will be created in the JSON body anyway.
@@ -631,6 +983,13 @@ func (r *PolicyResource) Create(
 		return
 	}
 
+	checkReferences(&resp.Diagnostics, true, func() ([]invalidReference, error) {
+		return invalidRuleWebhooks(r.ProviderData.Client, nil, plan.Rules, types.ListNull(plan.Rules.ElementType(ctx)))
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var policyError PolicyError
 	response, err := request.
 		SetBody(policy).
@@ -731,6 +1090,152 @@ func (r *PolicyResource) Read(
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// createPolicy creates the policy.
+func createPolicy(client *resty.Client, projectKey string, policy PolicyAPIModel) error {
+	request, err := getRestyRequest(client, projectKey)
+	if err != nil {
+		return err
+	}
+
+	var policyError PolicyError
+	response, err := request.
+		SetBody(policy).
+		SetError(&policyError).
+		Post(PoliciesEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("%s", policyError.Error)
+	}
+
+	return nil
+}
+
+// deletePolicy deletes the policy.
+func deletePolicy(client *resty.Client, projectKey, name string) error {
+	request, err := getRestyRequest(client, projectKey)
+	if err != nil {
+		return err
+	}
+
+	var policyError PolicyError
+	response, err := request.
+		SetPathParam("name", name).
+		SetError(&policyError).
+		Delete(PolicyEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("%s", policyError.Error)
+	}
+
+	return nil
+}
+
+// watchesAssignedPolicy returns the names of the watches the policy is
+// assigned to.
+func watchesAssignedPolicy(client *resty.Client, projectKey, policyName string) ([]string, error) {
+	request, err := getRestyRequest(client, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var watches []WatchAPIModel
+	response, err := request.
+		SetResult(&watches).
+		Get(WatchesEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("%s", response.String())
+	}
+
+	var watchNames []string
+	for _, watch := range watches {
+		assigned := lo.ContainsBy(watch.AssignedPolicies, func(policy WatchAssignedPolicyAPIModel) bool {
+			return policy.Name == policyName
+		})
+		if assigned {
+			watchNames = append(watchNames, watch.GeneralData.Name)
+		}
+	}
+
+	return watchNames, nil
+}
+
+// repointWatchPolicy assigns the policy to the watch in place of the previous
+// policy. The policy is assigned before the previous one is unassigned, so the
+// watch is never left without either of them.
+func repointWatchPolicy(ctx context.Context, client *resty.Client, projectKey, watchName string, from, to WatchAssignedPolicyAPIModel) error {
+	if err := assignWatchPolicy(ctx, client, projectKey, watchName, to, true); err != nil {
+		return err
+	}
+
+	if err := assignWatchPolicy(ctx, client, projectKey, watchName, from, false); err != nil {
+		if rollbackErr := assignWatchPolicy(ctx, client, projectKey, watchName, to, false); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to roll back watch %s: %w", watchName, rollbackErr))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// renamePolicy creates the policy under its new name, repoints the watches of
+// the previous policy to it, then deletes the previous policy. The completed
+// steps are rolled back when a step fails, leaving the previous policy in
+// place.
+func renamePolicy(ctx context.Context, client *resty.Client, projectKey string, previous WatchAssignedPolicyAPIModel, policy PolicyAPIModel) error {
+	watchNames, err := watchesAssignedPolicy(client, projectKey, previous.Name)
+	if err != nil {
+		return fmt.Errorf("failed to list the watches of policy %s: %w", previous.Name, err)
+	}
+
+	if err := createPolicy(client, projectKey, policy); err != nil {
+		return fmt.Errorf("failed to create policy %s: %w", policy.Name, err)
+	}
+
+	renamed := WatchAssignedPolicyAPIModel{
+		Name: policy.Name,
+		Type: policy.Type,
+	}
+
+	var repointed []string
+	rollback := func(err error) error {
+		errs := []error{err}
+		for _, watchName := range repointed {
+			if rollbackErr := repointWatchPolicy(ctx, client, projectKey, watchName, renamed, previous); rollbackErr != nil {
+				errs = append(errs, fmt.Errorf("failed to roll back watch %s: %w", watchName, rollbackErr))
+			}
+		}
+
+		if rollbackErr := deletePolicy(client, projectKey, policy.Name); rollbackErr != nil {
+			errs = append(errs, fmt.Errorf("failed to roll back policy %s: %w", policy.Name, rollbackErr))
+		}
+
+		return errors.Join(errs...)
+	}
+
+	for _, watchName := range watchNames {
+		if err := repointWatchPolicy(ctx, client, projectKey, watchName, previous, renamed); err != nil {
+			return rollback(fmt.Errorf("failed to assign policy %s to watch %s: %w", policy.Name, watchName, err))
+		}
+		repointed = append(repointed, watchName)
+	}
+
+	if err := deletePolicy(client, projectKey, previous.Name); err != nil {
+		return rollback(fmt.Errorf("failed to delete policy %s: %w", previous.Name, err))
+	}
+
+	return nil
+}
+
 func (r *PolicyResource) Update(
 	ctx context.Context,
 	toAPIModel func(context.Context, PolicyResourceModel, *PolicyAPIModel) diag.Diagnostics,
@@ -740,10 +1245,11 @@ func (r *PolicyResource) Update(
 ) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var plan PolicyResourceModel
+	var plan, state PolicyResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and state data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -763,26 +1269,45 @@ func (r *PolicyResource) Update(
 		return
 	}
 
-	var policyError PolicyError
-
-	response, err := request.
-		SetPathParam("name", plan.Name.ValueString()).
-		SetBody(policy).
-		SetError(&policyError).
-		Put(PolicyEndpoint)
-
-	if err != nil {
-		utilfw.UnableToUpdateResourceError(resp, err.Error())
+	checkReferences(&resp.Diagnostics, true, func() ([]invalidReference, error) {
+		return invalidRuleWebhooks(r.ProviderData.Client, nil, plan.Rules, types.ListNull(plan.Rules.ElementType(ctx)))
+	})
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, policyError.Error)
-		return
+	var policyError PolicyError
+
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		previousPolicy := WatchAssignedPolicyAPIModel{
+			Name: state.Name.ValueString(),
+			Type: state.Type.ValueString(),
+		}
+		err := renamePolicy(ctx, r.ProviderData.Client, state.ProjectKey.ValueString(), previousPolicy, policy)
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+	} else {
+		response, err := request.
+			SetPathParam("name", plan.Name.ValueString()).
+			SetBody(policy).
+			SetError(&policyError).
+			Put(PolicyEndpoint)
+
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+
+		if response.IsError() {
+			utilfw.UnableToUpdateResourceError(resp, policyError.Error)
+			return
+		}
 	}
 
 	var updatedPolicy PolicyAPIModel
-	response, err = request.
+	response, err := request.
 		SetResult(&updatedPolicy).
 		SetPathParam("name", plan.Name.ValueString()).
 		SetError(&policyError).
@@ -844,6 +1369,50 @@ func (r *PolicyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	// the resource from state if there are no other errors.
 }
 
+// ModifyPlan looks up the webhooks of the rule actions, to report webhooks
+// which don't exist when planning instead of failing the apply. Only the
+// webhooks not in the state are looked up, once per provider instance. It also
+// rejects renaming the policy while moving it to another project, as the
+// watches of the policy are repointed within its project.
+func (r *PolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed, or the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.ProviderData.Client == nil {
+		return
+	}
+
+	var plan PolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousRules := types.ListNull(plan.Rules.ElementType(ctx))
+	if !req.State.Raw.IsNull() {
+		var state PolicyResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		renamed := !plan.Name.IsUnknown() && plan.Name.ValueString() != state.Name.ValueString()
+		moved := !plan.ProjectKey.IsUnknown() && plan.ProjectKey.ValueString() != state.ProjectKey.ValueString()
+		if renamed && moved {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("project_key"),
+				"Invalid Policy Rename",
+				fmt.Sprintf("Policy '%s' can't be renamed to '%s' and moved from project '%s' to '%s' in the same apply, as the watches of the policy are assigned the new name within its project. Apply the rename and the change of 'project_key' separately.", state.Name.ValueString(), plan.Name.ValueString(), state.ProjectKey.ValueString(), plan.ProjectKey.ValueString()),
+			)
+			return
+		}
+
+		previousRules = state.Rules
+	}
+
+	checkReferences(&resp.Diagnostics, false, func() ([]invalidReference, error) {
+		return invalidRuleWebhooks(r.ProviderData.Client, referenceCacheFor(r.ProviderData.Client), plan.Rules, previousRules)
+	})
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *PolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.SplitN(req.ID, ":", 2)
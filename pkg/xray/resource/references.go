@@ -0,0 +1,333 @@
+package xray
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// invalidReference is a policy or webhook referenced by a resource which does
+// not exist in Xray, or which doesn't match the reference.
+type invalidReference struct {
+	path   path.Path
+	detail string
+}
+
+// addInvalidReferences reports the invalid references. When planning, the
+// referenced policies and webhooks may be created in the same apply, so they
+// are reported as warnings. Before applying, they are reported as errors
+// instead of the Xray error.
+func addInvalidReferences(diags *diag.Diagnostics, references []invalidReference, asError bool) {
+	for _, reference := range references {
+		if asError {
+			diags.AddAttributeError(reference.path, "Invalid reference", reference.detail)
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			reference.path,
+			"Invalid reference",
+			reference.detail+" The apply fails unless it is created before this resource in the same apply.",
+		)
+	}
+}
+
+// addReferencesLookupWarning reports references which could not be verified,
+// the request to Xray failing is not a reason to fail the plan.
+func addReferencesLookupWarning(diags *diag.Diagnostics, err error) {
+	diags.AddWarning(
+		"Unable to verify references",
+		fmt.Sprintf("The referenced policies and webhooks can't be verified: %s", err),
+	)
+}
+
+// referenceCache holds the policies and webhooks found in Xray when planning,
+// so each of them is looked up once per provider instance rather than once per
+// resource. Missing references are not cached, as they may be created earlier
+// in the same apply.
+type referenceCache struct {
+	mu sync.Mutex
+	// policyTypes holds the type of the policies found, by project key and
+	// name.
+	policyTypes map[string]string
+	webhooks    map[string]bool
+}
+
+// referenceCaches holds the reference cache of each provider instance, keyed
+// by its client.
+var referenceCaches sync.Map
+
+// referenceCacheFor returns the reference cache of the provider instance of
+// the client.
+func referenceCacheFor(client *resty.Client) *referenceCache {
+	cache, _ := referenceCaches.LoadOrStore(client, &referenceCache{
+		policyTypes: map[string]string{},
+		webhooks:    map[string]bool{},
+	})
+
+	return cache.(*referenceCache)
+}
+
+// policyType returns the type of the policy, if it was found before. A nil
+// cache has no policies.
+func (c *referenceCache) policyType(projectKey, name string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	policyType, found := c.policyTypes[projectKey+"/"+name]
+	return policyType, found
+}
+
+func (c *referenceCache) addPolicy(projectKey, name, policyType string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.policyTypes[projectKey+"/"+name] = policyType
+}
+
+// hasWebhook returns whether the webhook was found before. A nil cache has no
+// webhooks.
+func (c *referenceCache) hasWebhook(name string) bool {
+	if c == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.webhooks[name]
+}
+
+func (c *referenceCache) addWebhook(name string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.webhooks[name] = true
+}
+
+// getPolicy fetches the policy. The returned policy is nil if it does not
+// exist.
+func getPolicy(client *resty.Client, projectKey, name string) (*PolicyAPIModel, error) {
+	request, err := getRestyRequest(client, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy PolicyAPIModel
+	var policyError PolicyError
+	response, err := request.
+		SetPathParam("name", name).
+		SetResult(&policy).
+		SetError(&policyError).
+		Get(PolicyEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if response.IsError() {
+		return nil, fmt.Errorf("%s", policyError.Error)
+	}
+
+	return &policy, nil
+}
+
+// webhookExists checks whether the webhook exists.
+func webhookExists(client *resty.Client, name string) (bool, error) {
+	response, err := client.R().
+		SetPathParam("name", name).
+		Get(WebhookEndpoint)
+	if err != nil {
+		return false, err
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return false, nil
+	}
+
+	if response.IsError() {
+		return false, fmt.Errorf("%s", response.String())
+	}
+
+	return true, nil
+}
+
+// checkPolicyReference returns the problem of the reference to the policy of
+// the type, or an empty string if the policy exists with this type. The cache
+// may be nil, to always look up the policy.
+func checkPolicyReference(client *resty.Client, cache *referenceCache, projectKey, name, policyType string) (string, error) {
+	foundType, found := cache.policyType(projectKey, name)
+	if !found {
+		policy, err := getPolicy(client, projectKey, name)
+		if err != nil {
+			return "", err
+		}
+
+		if policy == nil {
+			return fmt.Sprintf("Policy '%s' does not exist.", name), nil
+		}
+
+		foundType = policy.Type
+		cache.addPolicy(projectKey, name, foundType)
+	}
+
+	if policyType != "" && foundType != policyType {
+		return fmt.Sprintf("Policy '%s' is a '%s' policy, not a '%s' policy.", name, foundType, policyType), nil
+	}
+
+	return "", nil
+}
+
+// invalidAssignedPolicies looks up the policies assigned to the watch. Unknown
+// values are skipped, they are checked before applying, and so are the
+// assignments in previous, which were applied already. The cache may be nil,
+// to always look up the policies.
+func invalidAssignedPolicies(client *resty.Client, cache *referenceCache, projectKey string, assignedPolicies, previous types.Set) ([]invalidReference, error) {
+	if assignedPolicies.IsNull() || assignedPolicies.IsUnknown() {
+		return nil, nil
+	}
+
+	var references []invalidReference
+	for _, elem := range assignedPolicies.Elements() {
+		attrs := elem.(types.Object).Attributes()
+		name := attrs["name"].(types.String)
+		policyType := attrs["type"].(types.String)
+		if name.IsUnknown() || policyType.IsUnknown() {
+			continue
+		}
+
+		if !previous.IsUnknown() && lo.ContainsBy(previous.Elements(), elem.Equal) {
+			continue
+		}
+
+		detail, err := checkPolicyReference(client, cache, projectKey, name.ValueString(), policyType.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		if detail != "" {
+			references = append(references, invalidReference{
+				path:   path.Root("assigned_policy").AtSetValue(elem).AtName("name"),
+				detail: detail,
+			})
+		}
+	}
+
+	return references, nil
+}
+
+// forEachRuleWebhook calls fn for each known webhook of the actions of the
+// policy rules.
+func forEachRuleWebhook(rules types.List, fn func(index int, action attr.Value, webhook types.String) error) error {
+	if rules.IsNull() || rules.IsUnknown() {
+		return nil
+	}
+
+	for index, rule := range rules.Elements() {
+		actions, ok := rule.(types.Object).Attributes()["actions"].(types.Set)
+		if !ok || actions.IsUnknown() {
+			continue
+		}
+
+		for _, action := range actions.Elements() {
+			webhooks, ok := action.(types.Object).Attributes()["webhooks"].(types.Set)
+			if !ok || webhooks.IsNull() || webhooks.IsUnknown() {
+				continue
+			}
+
+			for _, webhook := range webhooks.Elements() {
+				name := webhook.(types.String)
+				if name.IsUnknown() {
+					continue
+				}
+
+				if err := fn(index, action, name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// invalidRuleWebhooks looks up the webhooks of the actions of the policy
+// rules. Unknown values are skipped, they are checked before applying, and so
+// are the webhooks of the previous rules, which were applied already. The
+// cache may be nil, to always look up the webhooks.
+func invalidRuleWebhooks(client *resty.Client, cache *referenceCache, rules, previous types.List) ([]invalidReference, error) {
+	exists := map[string]bool{}
+	forEachRuleWebhook(previous, func(_ int, _ attr.Value, webhook types.String) error {
+		exists[webhook.ValueString()] = true
+		return nil
+	})
+
+	var references []invalidReference
+	err := forEachRuleWebhook(rules, func(index int, action attr.Value, webhook types.String) error {
+		name := webhook.ValueString()
+		found, checked := exists[name]
+		if !checked {
+			found = cache.hasWebhook(name)
+		}
+
+		if !checked && !found {
+			var err error
+			found, err = webhookExists(client, name)
+			if err != nil {
+				return err
+			}
+
+			if found {
+				cache.addWebhook(name)
+			}
+		}
+		exists[name] = found
+
+		if !found {
+			references = append(references, invalidReference{
+				path:   path.Root("rule").AtListIndex(index).AtName("actions").AtSetValue(action).AtName("webhooks").AtSetValue(webhook),
+				detail: fmt.Sprintf("Webhook '%s' does not exist.", name),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return references, nil
+}
+
+// checkReferences runs the lookup of the references and reports them.
+func checkReferences(diags *diag.Diagnostics, asError bool, lookup func() ([]invalidReference, error)) {
+	references, err := lookup()
+	if err != nil {
+		addReferencesLookupWarning(diags, err)
+		return
+	}
+
+	addInvalidReferences(diags, references, asError)
+}
@@ -2,9 +2,13 @@ package xray
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -17,31 +21,84 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/jfrog/terraform-provider-shared/util"
 	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
 	"github.com/samber/lo"
+	"golang.org/x/exp/slices"
 )
 
 const (
-	ReportsEndpoint = "xray/api/v1/reports/{reportType}"
-	ReportEndpoint  = "xray/api/v1/reports/{reportId}"
+	ReportsListEndpoint = "xray/api/v1/reports"
+	ReportsEndpoint     = "xray/api/v1/reports/{reportType}"
+	ReportEndpoint      = "xray/api/v1/reports/{reportId}"
+
+	ReportStatusCompleted = "completed"
+	ReportStatusFailed    = "failed"
+
+	defaultReportTimeoutInSeconds = 600
 )
 
+// reportPollInterval is the delay between two report status requests while
+// waiting for the report to complete.
+var reportPollInterval = 5 * time.Second
+
+// reportTypeNames lists the report types returned by the report details API
+// for each report type used to generate the report.
+var reportTypeNames = map[string][]string{
+	"exposures":        {"exposure", "exposures"},
+	"licenses":         {"license", "licenses"},
+	"operationalRisks": {"operational_risk", "operationalRisk", "operationalRisks"},
+	"violations":       {"violation", "violations"},
+	"vulnerabilities":  {"vulnerability", "vulnerabilities"},
+}
+
 type ReportResource struct {
 	ProviderData util.ProviderMetadata
 	TypeName     string
+	ReportType   string
 }
 
 type ReportResourceModel struct {
-	ID         types.String `tfsdk:"id"`
-	ReportID   types.Int64  `tfsdk:"report_id"`
-	Name       types.String `tfsdk:"name"`
-	ProjectKey types.String `tfsdk:"project_key"`
-	Resources  types.Set    `tfsdk:"resources"`
-	Filters    types.Set    `tfsdk:"filters"`
+	ID                      types.String `tfsdk:"id"`
+	ReportID                types.Int64  `tfsdk:"report_id"`
+	Name                    types.String `tfsdk:"name"`
+	ProjectKey              types.String `tfsdk:"project_key"`
+	TimeoutInSeconds        types.Int64  `tfsdk:"timeout_in_seconds"`
+	Status                  types.String `tfsdk:"status"`
+	TotalArtifacts          types.Int64  `tfsdk:"total_artifacts"`
+	NumOfProcessedArtifacts types.Int64  `tfsdk:"num_of_processed_artifacts"`
+	Progress                types.Int64  `tfsdk:"progress"`
+	NumberOfRows            types.Int64  `tfsdk:"number_of_rows"`
+	StartTime               types.String `tfsdk:"start_time"`
+	EndTime                 types.String `tfsdk:"end_time"`
+	Error                   types.String `tfsdk:"error"`
+	Resources               types.Set    `tfsdk:"resources"`
+	Filters                 types.Set    `tfsdk:"filters"`
+}
+
+func (m *ReportResourceModel) fromStatusAPIModel(status ReportStatusAPIModel) {
+	m.ReportID = types.Int64Value(status.ID)
+	m.Status = types.StringValue(status.Status)
+	m.TotalArtifacts = types.Int64Value(status.TotalArtifacts)
+	m.NumOfProcessedArtifacts = types.Int64Value(status.NumOfProcessedArtifacts)
+	m.Progress = types.Int64Value(status.Progress)
+	m.NumberOfRows = types.Int64Value(status.NumberOfRows)
+	m.StartTime = types.StringNull()
+	if status.StartTime != "" {
+		m.StartTime = types.StringValue(status.StartTime)
+	}
+	m.EndTime = types.StringNull()
+	if status.EndTime != "" {
+		m.EndTime = types.StringValue(status.EndTime)
+	}
+	m.Error = types.StringNull()
+	if status.Error != "" {
+		m.Error = types.StringValue(status.Error)
+	}
 }
 
 func (m ReportResourceModel) toAPIModel(
@@ -199,8 +256,246 @@ func (m ReportResourceModel) toAPIModel(
 	return diags
 }
 
+var startAndEndDateAttrTypes = map[string]attr.Type{
+	"start": types.StringType,
+	"end":   types.StringType,
+}
+
+var cvssScoreAttrTypes = map[string]attr.Type{
+	"min_score": types.Float64Type,
+	"max_score": types.Float64Type,
+}
+
+var repositoryResourceAttrTypes = map[string]attr.Type{
+	"name":                  types.StringType,
+	"include_path_patterns": types.SetType{ElemType: types.StringType},
+	"exclude_path_patterns": types.SetType{ElemType: types.StringType},
+}
+
+// buildsResourceAttrTypes is shared by the builds and release_bundles blocks.
+var buildsResourceAttrTypes = map[string]attr.Type{
+	"names":                     types.SetType{ElemType: types.StringType},
+	"include_patterns":          types.SetType{ElemType: types.StringType},
+	"exclude_patterns":          types.SetType{ElemType: types.StringType},
+	"number_of_latest_versions": types.Int64Type,
+}
+
+var projectsResourceAttrTypes = map[string]attr.Type{
+	"names":                     types.SetType{ElemType: types.StringType},
+	"include_key_patterns":      types.SetType{ElemType: types.StringType},
+	"number_of_latest_versions": types.Int64Type,
+}
+
+var reportResourcesAttrTypes = map[string]attr.Type{
+	"repository":      types.SetType{ElemType: types.ObjectType{AttrTypes: repositoryResourceAttrTypes}},
+	"builds":          types.SetType{ElemType: types.ObjectType{AttrTypes: buildsResourceAttrTypes}},
+	"release_bundles": types.SetType{ElemType: types.ObjectType{AttrTypes: buildsResourceAttrTypes}},
+	"projects":        types.SetType{ElemType: types.ObjectType{AttrTypes: projectsResourceAttrTypes}},
+}
+
+func stringSetValue(values []string) types.Set {
+	return types.SetValueMust(
+		types.StringType,
+		lo.Map(values, func(v string, _ int) attr.Value {
+			return types.StringValue(v)
+		}),
+	)
+}
+
+// stringValueOrNull maps empty strings of optional attributes without
+// default value to null.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// boolValueOrPrior keeps the prior value of an optional attribute when the
+// API returns false, as false values are omitted from the API model.
+func boolValueOrPrior(value bool, prior attr.Value) types.Bool {
+	if p, ok := prior.(types.Bool); ok && !value && !p.ValueBool() {
+		return p
+	}
+	return types.BoolValue(value)
+}
+
+// float64ValueOrPrior keeps the prior value of an optional attribute when the
+// API returns 0, as zero values are omitted from the API model.
+func float64ValueOrPrior(value float64, prior attr.Value) types.Float64 {
+	if p, ok := prior.(types.Float64); ok && value == 0 && p.ValueFloat64() == 0 {
+		return p
+	}
+	return types.Float64Value(value)
+}
+
+// numberOfLatestVersionsValue maps the omitted value back to the schema
+// default value.
+func numberOfLatestVersionsValue(value int64) types.Int64 {
+	if value == 0 {
+		return types.Int64Value(1)
+	}
+	return types.Int64Value(value)
+}
+
+// firstElementAttrs returns the attributes of the first element of a set
+// value, or nil if the value is not a set or has no elements.
+func firstElementAttrs(value attr.Value) map[string]attr.Value {
+	set, ok := value.(types.Set)
+	if !ok || len(set.Elements()) == 0 {
+		return nil
+	}
+
+	object, ok := set.Elements()[0].(types.Object)
+	if !ok {
+		return nil
+	}
+
+	return object.Attributes()
+}
+
+func startAndEndDateSetValue(date *StartAndEndDateAPIModel) types.Set {
+	elems := []attr.Value{}
+	if date != nil {
+		elems = append(elems, types.ObjectValueMust(
+			startAndEndDateAttrTypes,
+			map[string]attr.Value{
+				"start": stringValueOrNull(date.Start),
+				"end":   stringValueOrNull(date.End),
+			},
+		))
+	}
+
+	return types.SetValueMust(types.ObjectType{AttrTypes: startAndEndDateAttrTypes}, elems)
+}
+
+func cvssScoreSetValue(score *CVSSScoreAPIModel, prior attr.Value) types.Set {
+	elems := []attr.Value{}
+	if score != nil {
+		priorAttrs := firstElementAttrs(prior)
+		elems = append(elems, types.ObjectValueMust(
+			cvssScoreAttrTypes,
+			map[string]attr.Value{
+				"min_score": float64ValueOrPrior(score.MinScore, priorAttrs["min_score"]),
+				"max_score": float64ValueOrPrior(score.MaxScore, priorAttrs["max_score"]),
+			},
+		))
+	}
+
+	return types.SetValueMust(types.ObjectType{AttrTypes: cvssScoreAttrTypes}, elems)
+}
+
+func fromResourcesAPIModel(resources *ResourcesAPIModel) types.Set {
+	repositories := []attr.Value{}
+	if resources.Repositories != nil {
+		repositories = lo.Map(
+			*resources.Repositories,
+			func(repository RepositoryAPIModel, _ int) attr.Value {
+				return types.ObjectValueMust(
+					repositoryResourceAttrTypes,
+					map[string]attr.Value{
+						"name":                  types.StringValue(repository.Name),
+						"include_path_patterns": stringSetValue(repository.IncludePathPatterns),
+						"exclude_path_patterns": stringSetValue(repository.ExcludePathPatterns),
+					},
+				)
+			},
+		)
+	}
+
+	builds := []attr.Value{}
+	if resources.Builds != nil {
+		builds = append(builds, types.ObjectValueMust(
+			buildsResourceAttrTypes,
+			map[string]attr.Value{
+				"names":                     stringSetValue(resources.Builds.Names),
+				"include_patterns":          stringSetValue(resources.Builds.IncludePatterns),
+				"exclude_patterns":          stringSetValue(resources.Builds.ExcludePatterns),
+				"number_of_latest_versions": numberOfLatestVersionsValue(resources.Builds.NumberOfLatestVersions),
+			},
+		))
+	}
+
+	releaseBundles := []attr.Value{}
+	if resources.ReleaseBundles != nil {
+		releaseBundles = append(releaseBundles, types.ObjectValueMust(
+			buildsResourceAttrTypes,
+			map[string]attr.Value{
+				"names":                     stringSetValue(resources.ReleaseBundles.Names),
+				"include_patterns":          stringSetValue(resources.ReleaseBundles.IncludePatterns),
+				"exclude_patterns":          stringSetValue(resources.ReleaseBundles.ExcludePatterns),
+				"number_of_latest_versions": numberOfLatestVersionsValue(resources.ReleaseBundles.NumberOfLatestVersions),
+			},
+		))
+	}
+
+	projects := []attr.Value{}
+	if resources.Projects != nil {
+		projects = append(projects, types.ObjectValueMust(
+			projectsResourceAttrTypes,
+			map[string]attr.Value{
+				"names":                     stringSetValue(resources.Projects.Names),
+				"include_key_patterns":      stringSetValue(resources.Projects.IncludeKeyPatterns),
+				"number_of_latest_versions": numberOfLatestVersionsValue(resources.Projects.NumberOfLatestVersions),
+			},
+		))
+	}
+
+	return types.SetValueMust(
+		types.ObjectType{AttrTypes: reportResourcesAttrTypes},
+		[]attr.Value{
+			types.ObjectValueMust(
+				reportResourcesAttrTypes,
+				map[string]attr.Value{
+					"repository":      types.SetValueMust(types.ObjectType{AttrTypes: repositoryResourceAttrTypes}, repositories),
+					"builds":          types.SetValueMust(types.ObjectType{AttrTypes: buildsResourceAttrTypes}, builds),
+					"release_bundles": types.SetValueMust(types.ObjectType{AttrTypes: buildsResourceAttrTypes}, releaseBundles),
+					"projects":        types.SetValueMust(types.ObjectType{AttrTypes: projectsResourceAttrTypes}, projects),
+				},
+			),
+		},
+	)
+}
+
+// fromAPIModel maps the report definition returned by Xray into the model.
+// fromFiltersAPIModel receives the attributes of the prior filters so
+// optional values omitted by the API are not reported as drift.
+func (m *ReportResourceModel) fromAPIModel(
+	ctx context.Context,
+	apiModel ReportAPIModel,
+	fromFiltersAPIModel func(ctx context.Context, filters *FiltersAPIModel, priorAttrs map[string]attr.Value) (types.Object, diag.Diagnostics),
+) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	if apiModel.Name != "" {
+		m.Name = types.StringValue(apiModel.Name)
+	}
+
+	// Xray versions which do not return the report definition leave the
+	// prior values untouched instead of reporting drift on every refresh.
+	if apiModel.Resources != nil {
+		m.Resources = fromResourcesAPIModel(apiModel.Resources)
+	}
+
+	if apiModel.Filters != nil {
+		filters, d := fromFiltersAPIModel(ctx, apiModel.Filters, firstElementAttrs(m.Filters))
+		if d.HasError() {
+			diags.Append(d...)
+			return diags
+		}
+
+		filtersSet, d := types.SetValue(filters.Type(ctx), []attr.Value{filters})
+		if d.HasError() {
+			diags.Append(d...)
+		}
+		m.Filters = filtersSet
+	}
+
+	return diags
+}
+
 var reportsSchemaAttrs = lo.Assign(
-	projectKeySchemaAttrs(false, ""),
+	projectKeySchemaAttrs(true, ""),
 	map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed: true,
@@ -213,7 +508,7 @@ var reportsSchemaAttrs = lo.Assign(
 			PlanModifiers: []planmodifier.Int64{
 				int64planmodifier.UseStateForUnknown(),
 			},
-			Description: "Report ID",
+			Description: "ID of the report in Xray currently backing this resource. Changing the resources, filters, name or project key replaces the report.",
 		},
 		"name": schema.StringAttribute{
 			Required: true,
@@ -225,6 +520,47 @@ var reportsSchemaAttrs = lo.Assign(
 			},
 			Description: "Name of the report.",
 		},
+		"timeout_in_seconds": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(defaultReportTimeoutInSeconds),
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			Description: fmt.Sprintf("How long to wait for the report to be completed or failed after it is generated, in seconds. Set to `0` to not wait for the report. Changing it on a failed report doesn't wait for the report, which stays failed until it is replaced. Default value is `%d`.", defaultReportTimeoutInSeconds),
+		},
+		"status": schema.StringAttribute{
+			Computed:    true,
+			Description: "Status of the report, e.g. `pending`, `running`, `completed` or `failed`.",
+		},
+		"total_artifacts": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of artifacts included in the report.",
+		},
+		"num_of_processed_artifacts": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of artifacts processed so far.",
+		},
+		"progress": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Progress of the report generation, in percent.",
+		},
+		"number_of_rows": schema.Int64Attribute{
+			Computed:    true,
+			Description: "Number of rows in the report.",
+		},
+		"start_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "Time when the report generation started.",
+		},
+		"end_time": schema.StringAttribute{
+			Computed:    true,
+			Description: "Time when the report generation ended.",
+		},
+		"error": schema.StringAttribute{
+			Computed:    true,
+			Description: "Error message of a failed report.",
+		},
 	},
 )
 
@@ -445,6 +781,9 @@ var reportsBlocks = func(filtersAttrs map[string]schema.Attribute, filtersBlocks
 				setvalidator.IsRequired(),
 				setvalidator.SizeAtMost(1),
 			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
 			Description: "The list of resources to include into the report.",
 		},
 		"filters": schema.SetNestedBlock{
@@ -456,6 +795,9 @@ var reportsBlocks = func(filtersAttrs map[string]schema.Attribute, filtersBlocks
 				setvalidator.IsRequired(),
 				setvalidator.SizeAtMost(1),
 			},
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.RequiresReplace(),
+			},
 			Description: "Advanced filters.",
 		},
 	}
@@ -469,6 +811,21 @@ type ReportAPIModel struct {
 	Filters    *FiltersAPIModel   `json:"filters"`
 }
 
+type ReportStatusAPIModel struct {
+	ID                      int64  `json:"id"`
+	Name                    string `json:"name"`
+	ReportType              string `json:"report_type"`
+	Status                  string `json:"status"`
+	TotalArtifacts          int64  `json:"total_artifacts"`
+	NumOfProcessedArtifacts int64  `json:"num_of_processed_artifacts"`
+	Progress                int64  `json:"progress"`
+	NumberOfRows            int64  `json:"number_of_rows"`
+	StartTime               string `json:"start_time"`
+	EndTime                 string `json:"end_time"`
+	Author                  string `json:"author"`
+	Error                   string `json:"error"`
+}
+
 type ResourcesAPIModel struct {
 	Repositories   *[]RepositoryAPIModel   `json:"repositories,omitempty"`
 	Builds         *BuildsAPIModel         `json:"builds,omitempty"`
@@ -526,6 +883,7 @@ type FiltersAPIModel struct {
 	Component           string                   `json:"component,omitempty"`
 	Artifact            string                   `json:"artifact,omitempty"`
 	Severities          []string                 `json:"severities,omitempty"`
+	Category            string                   `json:"category,omitempty"` // Exposures report filter
 }
 
 type CVSSScoreAPIModel struct {
@@ -554,9 +912,80 @@ type LicenseFilterAPIModel struct {
 	LicensePatterns []string `json:"license_patterns,omitempty"`
 }
 
+func (r *ReportResource) getReportStatus(projectKey, reportID string, status *ReportStatusAPIModel) (*resty.Response, error) {
+	request, err := getRestyRequest(r.ProviderData.Client, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return request.
+		SetPathParam("reportId", reportID).
+		SetResult(status).
+		Get(ReportEndpoint)
+}
+
+// waitForReport polls the report status until the report is completed or
+// failed, or until the timeout is reached. A zero timeout fetches the status
+// once without waiting.
+func (r *ReportResource) waitForReport(ctx context.Context, projectKey, reportID string, timeout time.Duration) (ReportStatusAPIModel, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		var status ReportStatusAPIModel
+		response, err := r.getReportStatus(projectKey, reportID, &status)
+		if err != nil {
+			return status, err
+		}
+
+		if response.IsError() {
+			return status, fmt.Errorf("%s", response.String())
+		}
+
+		if timeout == 0 || status.Status == ReportStatusCompleted || status.Status == ReportStatusFailed {
+			return status, nil
+		}
+
+		if time.Now().After(deadline) {
+			return status, fmt.Errorf("timed out after %s waiting for report %s to complete, last status: %s", timeout, reportID, status.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-time.After(reportPollInterval):
+		}
+	}
+}
+
+// waitForReportDiags waits for the report of the model to complete and maps
+// its status into the model.
+func (r *ReportResource) waitForReportDiags(ctx context.Context, m *ReportResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	timeout := time.Duration(m.TimeoutInSeconds.ValueInt64()) * time.Second
+	status, err := r.waitForReport(ctx, m.ProjectKey.ValueString(), m.ID.ValueString(), timeout)
+	if err != nil {
+		diags.AddError(
+			"failed to wait for report",
+			err.Error(),
+		)
+		return diags
+	}
+
+	m.fromStatusAPIModel(status)
+
+	if status.Status == ReportStatusFailed {
+		diags.AddError(
+			"report failed",
+			fmt.Sprintf("report '%s' (%s) failed: %s", m.Name.ValueString(), m.ID.ValueString(), status.Error),
+		)
+	}
+
+	return diags
+}
+
 func (r *ReportResource) Create(
 	ctx context.Context,
-	reportType string,
 	toAPIModel func(context.Context, ReportResourceModel, *ReportAPIModel) diag.Diagnostics,
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
@@ -587,7 +1016,7 @@ func (r *ReportResource) Create(
 	}
 
 	response, err := request.
-		SetPathParam("reportType", reportType).
+		SetPathParam("reportType", r.ReportType).
 		SetBody(report).
 		SetResult(&report).
 		Post(ReportsEndpoint)
@@ -603,13 +1032,20 @@ func (r *ReportResource) Create(
 	}
 
 	plan.ID = types.StringValue(fmt.Sprintf("%d", report.ReportId))
-	plan.ReportID = types.Int64Null()
+	plan.ReportID = types.Int64Value(report.ReportId)
+
+	resp.Diagnostics.Append(r.waitForReportDiags(ctx, &plan)...)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
-func (r *ReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+func (r *ReportResource) Read(
+	ctx context.Context,
+	fromAPIModel func(context.Context, ReportAPIModel, *ReportResourceModel) diag.Diagnostics,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
 	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
 	var state ReportResourceModel
@@ -620,90 +1056,97 @@ func (r *ReportResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	request, err := getRestyRequest(r.ProviderData.Client, state.ProjectKey.ValueString())
+	var status ReportStatusAPIModel
+	response, err := r.getReportStatus(state.ProjectKey.ValueString(), state.ID.ValueString(), &status)
 	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToRefreshResourceError(resp, response.String())
+		return
+	}
+
+	// Imported reports have no resources yet, make sure the report can be
+	// managed by this resource type before adopting it.
+	if state.Resources.IsNull() && status.ReportType != "" && !slices.Contains(reportTypeNames[r.ReportType], status.ReportType) {
 		resp.Diagnostics.AddError(
-			"failed to get Resty client",
-			err.Error(),
+			"invalid report type",
+			fmt.Sprintf("report %s is a '%s' report and cannot be managed by %s", state.ID.ValueString(), status.ReportType, r.TypeName),
 		)
 		return
 	}
 
-	response, err := request.
-		SetPathParam("reportId", state.ID.ValueString()).
-		Get(ReportEndpoint)
+	state.fromStatusAPIModel(status)
 
-	if err != nil {
+	var report ReportAPIModel
+	if err := json.Unmarshal(response.Body(), &report); err != nil {
 		utilfw.UnableToRefreshResourceError(resp, err.Error())
 		return
 	}
 
-	if response.StatusCode() == http.StatusNotFound {
-		resp.State.RemoveResource(ctx)
+	resp.Diagnostics.Append(fromAPIModel(ctx, report, &state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if response.IsError() {
-		utilfw.UnableToRefreshResourceError(resp, response.String())
-		return
+	// backward compatibility with reports created before the timeout was added
+	if state.TimeoutInSeconds.IsNull() {
+		state.TimeoutInSeconds = types.Int64Value(defaultReportTimeoutInSeconds)
 	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *ReportResource) Update(
-	ctx context.Context,
-	reportType string,
-	toAPIModel func(context.Context, ReportResourceModel, *ReportAPIModel) diag.Diagnostics,
-	req resource.UpdateRequest,
-	resp *resource.UpdateResponse,
-) {
+// Update only handles changes of attributes that do not affect the report
+// itself, any other change replaces the report so the superseded one is
+// deleted from Xray. A failed report is not generated again, so its status is
+// refreshed without waiting for it nor failing the update.
+func (r *ReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var plan ReportResourceModel
+	var plan, state ReportResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	request, err := getRestyRequest(r.ProviderData.Client, plan.ProjectKey.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"failed to get Resty client",
-			err.Error(),
-		)
-		return
-	}
+	if state.Status.ValueString() == ReportStatusFailed {
+		status, err := r.waitForReport(ctx, plan.ProjectKey.ValueString(), plan.ID.ValueString(), 0)
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
 
-	var report ReportAPIModel
-	resp.Diagnostics.Append(toAPIModel(ctx, plan, &report)...)
-	if resp.Diagnostics.HasError() {
-		return
+		plan.fromStatusAPIModel(status)
+	} else {
+		resp.Diagnostics.Append(r.waitForReportDiags(ctx, &plan)...)
 	}
 
-	response, err := request.
-		SetPathParam("reportType", reportType).
-		SetBody(report).
-		SetResult(&report).
-		Post(ReportsEndpoint)
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
 
-	if err != nil {
-		utilfw.UnableToUpdateResourceError(resp, err.Error())
-		return
-	}
+func (r *ReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
 
-	if response.IsError() {
-		utilfw.UnableToUpdateResourceError(resp, response.String())
-		return
+	if len(parts) > 0 && parts[0] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[0])...)
 	}
 
-	plan.ID = types.StringValue(fmt.Sprintf("%d", report.ReportId))
-
-	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if len(parts) == 2 && parts[1] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), parts[1])...)
+	}
 }
 
 func (r *ReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
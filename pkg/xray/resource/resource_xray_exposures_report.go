@@ -0,0 +1,191 @@
+package xray
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+)
+
+var _ resource.Resource = &ExposuresReportResource{}
+
+func NewExposuresReportResource() resource.Resource {
+	return &ExposuresReportResource{
+		ReportResource: ReportResource{
+			TypeName:   "xray_exposures_report",
+			ReportType: "exposures",
+		},
+	}
+}
+
+type ExposuresReportResource struct {
+	ReportResource
+}
+
+func (r *ExposuresReportResource) toFiltersAPIModel(ctx context.Context, filtersElems []attr.Value) (*FiltersAPIModel, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	var filters *FiltersAPIModel
+	if len(filtersElems) > 0 {
+		attrs := filtersElems[0].(types.Object).Attributes()
+
+		var severities []string
+		d := attrs["severities"].(types.Set).ElementsAs(ctx, &severities, false)
+		if d.HasError() {
+			diags.Append(d...)
+		}
+
+		var scanDate *StartAndEndDateAPIModel
+		scanDateElems := attrs["scan_date"].(types.Set).Elements()
+		if len(scanDateElems) > 0 {
+			attrs := scanDateElems[0].(types.Object).Attributes()
+
+			scanDate = &StartAndEndDateAPIModel{
+				Start: attrs["start"].(types.String).ValueString(),
+				End:   attrs["end"].(types.String).ValueString(),
+			}
+		}
+
+		filters = &FiltersAPIModel{
+			Category:         attrs["category"].(types.String).ValueString(),
+			ImpactedArtifact: attrs["impacted_artifact"].(types.String).ValueString(),
+			Severities:       severities,
+			ScanDate:         scanDate,
+		}
+	}
+
+	return filters, diags
+}
+
+var exposuresFiltersAttrTypes = map[string]attr.Type{
+	"category":          types.StringType,
+	"impacted_artifact": types.StringType,
+	"severities":        types.SetType{ElemType: types.StringType},
+	"scan_date":         types.SetType{ElemType: types.ObjectType{AttrTypes: startAndEndDateAttrTypes}},
+}
+
+func (r *ExposuresReportResource) fromFiltersAPIModel(ctx context.Context, filters *FiltersAPIModel, priorAttrs map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(
+		exposuresFiltersAttrTypes,
+		map[string]attr.Value{
+			"category":          types.StringValue(filters.Category),
+			"impacted_artifact": stringValueOrNull(filters.ImpactedArtifact),
+			"severities":        stringSetValue(filters.Severities),
+			"scan_date":         startAndEndDateSetValue(filters.ScanDate),
+		},
+	)
+}
+
+func (r ExposuresReportResource) fromAPIModel(ctx context.Context, report ReportAPIModel, state *ReportResourceModel) diag.Diagnostics {
+	return state.fromAPIModel(ctx, report, r.fromFiltersAPIModel)
+}
+
+func (r ExposuresReportResource) toAPIModel(ctx context.Context, plan ReportResourceModel, report *ReportAPIModel) diag.Diagnostics {
+	return plan.toAPIModel(ctx, report, r.toFiltersAPIModel)
+}
+
+func (r *ExposuresReportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+var exposuresFiltersAttrs = map[string]schema.Attribute{
+	"category": schema.StringAttribute{
+		Required: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(exposureCategories...),
+		},
+		Description: "Exposures category. Allowed values: 'secrets', 'services', 'applications', 'iac'.",
+	},
+	"impacted_artifact": schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+		Description: "Filter by impacted artifact name, you can use (*) at the beginning or end of a substring as a wildcard.",
+	},
+	"severities": schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(
+				stringvalidator.OneOf("Low", "Medium", "High", "Critical"),
+			),
+		},
+		Description: "Severity levels. Allowed values: 'Low', 'Medium', 'High', 'Critical'.",
+	},
+}
+
+var exposuresFiltersBlocks = map[string]schema.Block{
+	"scan_date": schema.SetNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"start": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						IsRFC3339Time(),
+					},
+					Description: "Scan start date.",
+				},
+				"end": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						IsRFC3339Time(),
+					},
+					Description: "Scan end date.",
+				},
+			},
+		},
+		Validators: []validator.Set{
+			setvalidator.SizeAtMost(1),
+		},
+	},
+}
+
+func (r *ExposuresReportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: reportsSchemaAttrs,
+		Blocks:     reportsBlocks(exposuresFiltersAttrs, exposuresFiltersBlocks),
+		Description: "Creates Xray Exposures report. The Exposures report provides you with information on exposures " +
+			"found by the JFrog Advanced Security scanners for one category: secrets, services, applications or " +
+			"infrastructure as code (IaC). Requires JFrog Advanced Security.",
+	}
+}
+
+func (r *ExposuresReportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *ExposuresReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.ReportResource.Create(ctx, r.toAPIModel, req, resp)
+}
+
+func (r *ExposuresReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.ReportResource.Read(ctx, r.fromAPIModel, req, resp)
+}
+
+func (r *ExposuresReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.ReportResource.Update(ctx, req, resp)
+}
+
+func (r *ExposuresReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ReportResource.ImportState(ctx, req, resp)
+}
+
+func (r *ExposuresReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.ReportResource.Delete(ctx, req, resp)
+}
@@ -2,10 +2,12 @@ package xray
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"regexp"
 	"time"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -31,7 +34,15 @@ const (
 	IgnoreRuleEndpoint  = "xray/api/v1/ignore_rules/{id}"
 )
 
+const (
+	onExpiryKeep     = "keep"
+	onExpiryRecreate = "recreate"
+	onExpiryRemove   = "remove"
+)
+
 var _ resource.Resource = &IgnoreRuleResource{}
+var _ resource.ResourceWithModifyPlan = &IgnoreRuleResource{}
+var _ resource.ResourceWithValidateConfig = &IgnoreRuleResource{}
 
 func NewIgnoreRuleResource() resource.Resource {
 	return &IgnoreRuleResource{
@@ -71,6 +82,28 @@ type IgnoreRuleResourceModel struct {
 	Artifacts        types.Set    `tfsdk:"artifact"`
 }
 
+// ignoreRuleResourceStateModel holds the attributes which only exist on the
+// xray_ignore_rule resource, IgnoreRuleResourceModel is shared with the
+// xray_ignore_rules data source.
+type ignoreRuleResourceStateModel struct {
+	IgnoreRuleResourceModel
+	PreviousIDs types.List   `tfsdk:"previous_ids"`
+	OnExpiry    types.String `tfsdk:"on_expiry"`
+	RenewFor    types.String `tfsdk:"renew_for"`
+	ExpiresIn   types.String `tfsdk:"expires_in"`
+}
+
+// expirationDateIn returns the expiration date of an ignore rule created
+// today and valid for the given duration.
+func expirationDateIn(duration types.String) (string, error) {
+	d, err := parseDuration(duration.ValueString())
+	if err != nil {
+		return "", err
+	}
+
+	return time.Now().Add(d).Format("2006-01-02"), nil
+}
+
 func unpackFilterNameVersion(elem attr.Value, _ int) IgnoreFilterNameVersionAPIModel {
 	attrs := elem.(types.Object).Attributes()
 	return IgnoreFilterNameVersionAPIModel{
@@ -557,6 +590,12 @@ func (m *IgnoreRuleResourceModel) fromAPIModel(ctx context.Context, apiModel Ign
 	return diags
 }
 
+// FromAPIModel maps the ignore rule into the model the same way as the
+// xray_ignore_rule resource does.
+func (m *IgnoreRuleResourceModel) FromAPIModel(ctx context.Context, apiModel IgnoreRuleAPIModel) diag.Diagnostics {
+	return m.fromAPIModel(ctx, apiModel)
+}
+
 type IgnoreRuleAPIModel struct {
 	ID            string                `json:"id,omitempty"`
 	Author        string                `json:"author,omitempty"`
@@ -622,24 +661,19 @@ func (r *IgnoreRuleResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "Project key for assigning this resource to. Must be 2 - 10 lowercase alphanumeric and hyphen characters.",
 			},
 			"notes": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-				Description: "Notes of the ignore rule",
+				Required:    true,
+				Description: "Notes of the ignore rule. Changing it swaps the ignore rule for a new one in Xray, see `previous_ids`.",
 			},
 			"expiration_date": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(
 						regexp.MustCompile(`^\d{4}-(0[1-9]|1[012])-(0[1-9]|[12][0-9]|3[0-1])$`),
 						"Date must be in YYYY-MM-DD format",
 					),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-				Description: "The Ignore Rule will be active until the expiration date. At that date it will automatically get deleted. The rule with the expiration date less than current day, will error out. Vaule assumes to be in local timezone. Ensure client and server time zones match.",
+				Description: "The Ignore Rule will be active until the expiration date. At that date it will automatically get deleted. The rule with the expiration date less than current day, will error out. Vaule assumes to be in local timezone. Ensure client and server time zones match. Changing it swaps the ignore rule for a new one in Xray, see `previous_ids`. Conflicts with `on_expiry` set to `recreate`, which computes it from `renew_for` instead, with `on_expiry` set to `remove`, and with `expires_in`.",
 			},
 			"author": schema.StringAttribute{
 				Computed: true,
@@ -650,6 +684,35 @@ func (r *IgnoreRuleResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"is_expired": schema.BoolAttribute{
 				Computed: true,
 			},
+			"on_expiry": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(onExpiryKeep),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onExpiryKeep, onExpiryRecreate, onExpiryRemove),
+				},
+				Description: "What to do once the ignore rule has expired. `keep` leaves the expired rule in the state, `recreate` plans a replacement of the rule expiring after `renew_for`, and `remove` drops the rule from the state so it is planned for creation again, expiring after `expires_in`. `remove` can't be combined with `expiration_date`. Default value is `keep`.",
+			},
+			"expires_in": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					IsDuration(),
+					stringvalidator.ConflictsWith(path.MatchRoot("expiration_date")),
+				},
+				Description: "How long the ignore rule is valid for, in days (e.g. `30d`) or as a Go duration (e.g. `720h`). The expiration date is resolved once when the rule is created and stored in `expiration_date`, it is only resolved again when `expires_in` changes. Conflicts with `expiration_date`.",
+			},
+			"renew_for": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					IsDuration(),
+				},
+				Description: "How long the ignore rule is valid for when `on_expiry` is set to `recreate`, in days (e.g. `30d`) or as a Go duration (e.g. `720h`). The expiration date is computed from it when the rule is created or renewed.",
+			},
+			"previous_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the ignore rules this resource previously managed, oldest first. Xray can't modify an ignore rule, so changing `notes` or `expiration_date` creates a new rule and deletes the previous one.",
+			},
 			"vulnerabilities": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -906,7 +969,7 @@ func (r *IgnoreRuleResource) Schema(ctx context.Context, req resource.SchemaRequ
 						Optional:    true,
 						Validators: []validator.Set{
 							setvalidator.SizeAtLeast(1),
-							setvalidator.ValueStringsAre(stringvalidator.OneOf("secrets", "services", "applications", "iac")),
+							setvalidator.ValueStringsAre(stringvalidator.OneOf(exposureCategories...)),
 						},
 						Description: "Ignores all violations of the specific exposures category. Include one or more exposure categories: 'secrets', 'services', 'applications', or 'iac'.",
 					},
@@ -941,48 +1004,23 @@ func (r *IgnoreRuleResource) Configure(ctx context.Context, req resource.Configu
 	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
 }
 
-func (r *IgnoreRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
-
-	var plan IgnoreRuleResourceModel
-
-	// Read Terraform plan data into the model
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	request, err := getRestyRequest(r.ProviderData.Client, plan.ProjectKey.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"failed to get Resty client",
-			err.Error(),
-		)
-		return
-	}
-
+// createIgnoreRule creates the ignore rule and fetches it back to get the
+// computed fields filled in by Xray.
+func createIgnoreRule(request *resty.Request, ignoreRule IgnoreRuleAPIModel) (IgnoreRuleAPIModel, error) {
 	type IgnoreRuleCreateResult struct {
 		Info string `json:"info"`
 	}
 
-	var ignoreRule IgnoreRuleAPIModel
-	resp.Diagnostics.Append(plan.toAPIModel(ctx, &ignoreRule)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	var result IgnoreRuleCreateResult
 	response, err := request.
 		SetBody(ignoreRule).
 		SetResult(&result).
 		Post(IgnoreRulesEndpoint)
 	if err != nil {
-		utilfw.UnableToCreateResourceError(resp, err.Error())
-		return
+		return ignoreRule, err
 	}
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, response.String())
-		return
+		return ignoreRule, fmt.Errorf("%s", response.String())
 	}
 
 	// response is in this json structure:
@@ -992,22 +1030,70 @@ func (r *IgnoreRuleResource) Create(ctx context.Context, req resource.CreateRequ
 	// use regex to match the group for the ID
 	re := regexp.MustCompile(`(?m)^Successfully added Ignore rule with id: (.+)$`)
 	matches := re.FindStringSubmatch(result.Info)
-	if len(matches) > 1 {
-		plan.ID = types.StringValue(matches[1])
+	if len(matches) < 2 {
+		return ignoreRule, fmt.Errorf("failed to find ignore rule ID in response: %s", result.Info)
 	}
 
 	// Fetch the ignore rule to fill out computed fields
+	var created IgnoreRuleAPIModel
 	response, err = request.
-		SetPathParam("id", plan.ID.ValueString()).
-		SetResult(&ignoreRule).
+		SetBody(nil).
+		SetPathParam("id", matches[1]).
+		SetResult(&created).
 		Get(IgnoreRuleEndpoint)
 	if err != nil {
-		utilfw.UnableToCreateResourceError(resp, err.Error())
-		return
+		return ignoreRule, err
+	}
+	if response.IsError() {
+		return ignoreRule, fmt.Errorf("%s", response.String())
 	}
 
+	return created, nil
+}
+
+func deleteIgnoreRule(request *resty.Request, id string) error {
+	response, err := request.
+		SetPathParam("id", id).
+		Delete(IgnoreRuleEndpoint)
+	if err != nil {
+		return err
+	}
 	if response.IsError() {
-		utilfw.UnableToCreateResourceError(resp, response.String())
+		return fmt.Errorf("%s", response.String())
+	}
+
+	return nil
+}
+
+func (r *IgnoreRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ignoreRuleResourceStateModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, err := getRestyRequest(r.ProviderData.Client, plan.ProjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get Resty client",
+			err.Error(),
+		)
+		return
+	}
+
+	var ignoreRule IgnoreRuleAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &ignoreRule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ignoreRule, err = createIgnoreRule(request, ignoreRule)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
 		return
 	}
 
@@ -1015,6 +1101,7 @@ func (r *IgnoreRuleResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	plan.PreviousIDs = types.ListValueMust(types.StringType, []attr.Value{})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -1023,7 +1110,7 @@ func (r *IgnoreRuleResource) Create(ctx context.Context, req resource.CreateRequ
 func (r *IgnoreRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var state IgnoreRuleResourceModel
+	var state ignoreRuleResourceStateModel
 
 	// Read Terraform state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -1065,19 +1152,275 @@ func (r *IgnoreRuleResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if state.PreviousIDs.IsNull() {
+		state.PreviousIDs = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+	if state.OnExpiry.IsNull() {
+		state.OnExpiry = types.StringValue(onExpiryKeep)
+	}
+
+	if state.IsExpired.ValueBool() && state.OnExpiry.ValueString() == onExpiryRemove {
+		resp.Diagnostics.AddWarning(
+			"Ignore rule expired",
+			fmt.Sprintf("Ignore rule %s expired on %s and is removed from the state as 'on_expiry' is set to '%s'.", state.ID.ValueString(), state.ExpiredAt.ValueString(), onExpiryRemove),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
+// Update swaps the ignore rule for a new one as Xray has no API to modify an
+// existing ignore rule. The new rule is created first so the violations stay
+// ignored during the swap, then the previous rule is deleted. If the previous
+// rule can't be deleted, the new rule is deleted again and the state is left
+// untouched.
 func (r *IgnoreRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// noop
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan, state ignoreRuleResourceStateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only 'on_expiry', 'renew_for' or 'expires_in' changed, there is nothing
+	// to change in Xray.
+	if plan.Notes.Equal(state.Notes) && plan.ExpiredAt.Equal(state.ExpiredAt) {
+		state.OnExpiry = plan.OnExpiry
+		state.RenewFor = plan.RenewFor
+		state.ExpiresIn = plan.ExpiresIn
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	var ignoreRule IgnoreRuleAPIModel
+	resp.Diagnostics.Append(plan.toAPIModel(ctx, &ignoreRule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ignoreRule.ID = ""
+
+	request, err := getRestyRequest(r.ProviderData.Client, plan.ProjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get Resty client",
+			err.Error(),
+		)
+		return
+	}
+
+	newIgnoreRule, err := createIgnoreRule(request, ignoreRule)
+	if err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	request, err = getRestyRequest(r.ProviderData.Client, state.ProjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get Resty client",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := deleteIgnoreRule(request, state.ID.ValueString()); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, fmt.Sprintf("failed to delete previous ignore rule %s: %s", state.ID.ValueString(), err))
+
+		request, err := getRestyRequest(r.ProviderData.Client, plan.ProjectKey.ValueString())
+		if err == nil {
+			err = deleteIgnoreRule(request, newIgnoreRule.ID)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"failed to roll back ignore rule update",
+				fmt.Sprintf("Ignore rule %s was created but could not be deleted, remove it manually: %s", newIgnoreRule.ID, err),
+			)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(plan.fromAPIModel(ctx, newIgnoreRule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousIDs := []attr.Value{}
+	if !state.PreviousIDs.IsNull() && !state.PreviousIDs.IsUnknown() {
+		previousIDs = append(previousIDs, state.PreviousIDs.Elements()...)
+	}
+	previousIDs = append(previousIDs, state.ID)
+	plan.PreviousIDs = types.ListValueMust(types.StringType, previousIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r IgnoreRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ignoreRuleResourceStateModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.OnExpiry.IsUnknown() {
+		return
+	}
+
+	if config.OnExpiry.ValueString() == onExpiryRecreate {
+		if config.RenewFor.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("renew_for"),
+				"Missing attribute configuration",
+				fmt.Sprintf("Attribute 'renew_for' must be set when 'on_expiry' is set to '%s'", onExpiryRecreate),
+			)
+		}
+
+		if !config.ExpiredAt.IsNull() && !config.ExpiredAt.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expiration_date"),
+				"Invalid attribute values combination",
+				fmt.Sprintf("Attribute 'expiration_date' can't be set when 'on_expiry' is set to '%s', it is computed from 'renew_for'", onExpiryRecreate),
+			)
+		}
+
+		if !config.ExpiresIn.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_in"),
+				"Invalid attribute values combination",
+				fmt.Sprintf("Attribute 'expires_in' can't be set when 'on_expiry' is set to '%s', use 'renew_for' instead", onExpiryRecreate),
+			)
+		}
+	} else if !config.RenewFor.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("renew_for"),
+			"Invalid attribute values combination",
+			fmt.Sprintf("Attribute 'renew_for' can only be set when 'on_expiry' is set to '%s'", onExpiryRecreate),
+		)
+	}
+
+	// Once removed from the state, the rule is planned for creation again, with
+	// a fixed expiration date which has passed by then and which Xray rejects.
+	// 'expires_in' is resolved again when the rule is created again.
+	if config.OnExpiry.ValueString() == onExpiryRemove && !config.ExpiredAt.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiration_date"),
+			"Invalid attribute values combination",
+			fmt.Sprintf("Attribute 'expiration_date' can't be set when 'on_expiry' is set to '%s', as the removed rule could not be created again once the date has passed. Use 'expires_in' instead", onExpiryRemove),
+		)
+	}
+}
+
+// ModifyPlan plans the expiration date, which is computed when 'on_expiry' is
+// set to 'recreate', and surfaces expired ignore rules in the plan.
+func (r *IgnoreRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed, nothing to plan.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ignoreRuleResourceStateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config ignoreRuleResourceStateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *ignoreRuleResourceStateModel
+	if !req.State.Raw.IsNull() {
+		state = &ignoreRuleResourceStateModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.OnExpiry.IsUnknown() || plan.RenewFor.IsUnknown() || plan.ExpiresIn.IsUnknown() {
+		return
+	}
+
+	if state != nil && state.IsExpired.ValueBool() && plan.OnExpiry.ValueString() == onExpiryKeep {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expiration_date"),
+			"Ignore rule expired",
+			fmt.Sprintf("Ignore rule %s expired on %s and no longer ignores any violation. Set 'on_expiry' to '%s' or '%s' to handle expired ignore rules.", state.ID.ValueString(), state.ExpiredAt.ValueString(), onExpiryRecreate, onExpiryRemove),
+		)
+	}
+
+	// Expiration date is set in the configuration, use it as is.
+	if !config.ExpiredAt.IsNull() {
+		return
+	}
+
+	// Expiration date is resolved from 'expires_in' when the rule is created,
+	// then kept as is until 'expires_in' changes.
+	if !plan.ExpiresIn.IsNull() {
+		if state != nil && state.ExpiresIn.Equal(plan.ExpiresIn) && !state.ExpiredAt.IsNull() {
+			plan.ExpiredAt = state.ExpiredAt
+		} else {
+			expirationDate, err := expirationDateIn(plan.ExpiresIn)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("expires_in"),
+					"Invalid Duration Format",
+					err.Error(),
+				)
+				return
+			}
+			plan.ExpiredAt = types.StringValue(expirationDate)
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expiration_date"), plan.ExpiredAt)...)
+		return
+	}
+
+	switch plan.OnExpiry.ValueString() {
+	case onExpiryRecreate:
+		if state != nil && !state.IsExpired.ValueBool() && !state.ExpiredAt.IsNull() {
+			plan.ExpiredAt = state.ExpiredAt
+			break
+		}
+
+		expirationDate, err := expirationDateIn(plan.RenewFor)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("renew_for"),
+				"Invalid Duration Format",
+				err.Error(),
+			)
+			return
+		}
+		plan.ExpiredAt = types.StringValue(expirationDate)
+
+		if state != nil && state.IsExpired.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("expiration_date"),
+				"Ignore rule expired",
+				fmt.Sprintf("Ignore rule %s expired on %s and will be recreated to expire on %s as 'on_expiry' is set to '%s'.", state.ID.ValueString(), state.ExpiredAt.ValueString(), expirationDate, onExpiryRecreate),
+			)
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expiration_date"))
+		}
+	default:
+		plan.ExpiredAt = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expiration_date"), plan.ExpiredAt)...)
 }
 
 func (r *IgnoreRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var state IgnoreRuleResourceModel
+	var state ignoreRuleResourceStateModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -1091,20 +1434,11 @@ func (r *IgnoreRuleResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	response, err := request.
-		SetPathParam("id", state.ID.ValueString()).
-		Delete(IgnoreRuleEndpoint)
-
-	if err != nil {
+	if err := deleteIgnoreRule(request, state.ID.ValueString()); err != nil {
 		utilfw.UnableToDeleteResourceError(resp, err.Error())
 		return
 	}
 
-	if response.IsError() {
-		utilfw.UnableToDeleteResourceError(resp, response.String())
-		return
-	}
-
 	// If the logic reaches here, it implicitly succeeded and will remove
 	// the resource from state if there are no other errors.
 }
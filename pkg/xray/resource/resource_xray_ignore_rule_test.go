@@ -1,8 +1,11 @@
 package xray_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"strings"
@@ -10,11 +13,18 @@ import (
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+	xray "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
 )
 
 func TestAccIgnoreRule_UpgradeFromSDKv2(t *testing.T) {
@@ -159,6 +169,81 @@ func TestAccIgnoreRule_operational_risk(t *testing.T) {
 	})
 }
 
+func TestAccIgnoreRule_update_notes_and_expiration(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("ignore-rule-", "xray_ignore_rule")
+	expirationDate := time.Now().Add(time.Hour * 48)
+	updatedExpirationDate := time.Now().Add(time.Hour * 96)
+
+	template := `
+		resource "xray_ignore_rule" "{{ .name }}" {
+		  notes           = "{{ .notes }}"
+		  expiration_date = "{{ .expirationDate }}"
+		  cves            = ["fake-cve"]
+		}
+	`
+
+	config := util.ExecuteTemplate("TestAccIgnoreRule", template, map[string]interface{}{
+		"name":           name,
+		"notes":          "fake notes",
+		"expirationDate": expirationDate.Format("2006-01-02"),
+	})
+
+	updatedConfig := util.ExecuteTemplate("TestAccIgnoreRule", template, map[string]interface{}{
+		"name":           name,
+		"notes":          "updated notes",
+		"expirationDate": updatedExpirationDate.Format("2006-01-02"),
+	})
+
+	var firstID string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", testCheckIgnoreRule),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "notes", "fake notes"),
+					resource.TestCheckResourceAttr(fqrn, "previous_ids.#", "0"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources[fqrn].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "notes", "updated notes"),
+					resource.TestCheckResourceAttr(fqrn, "expiration_date", updatedExpirationDate.Format("2006-01-02")),
+					resource.TestCheckResourceAttr(fqrn, "previous_ids.#", "1"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources[fqrn]
+						if rs.Primary.ID == firstID {
+							return fmt.Errorf("expected a new ignore rule ID, got %s", rs.Primary.ID)
+						}
+						if previousID := rs.Primary.Attributes["previous_ids.0"]; previousID != firstID {
+							return fmt.Errorf("expected previous_ids.0 to be %s, got %s", firstID, previousID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            fqrn,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"previous_ids"},
+			},
+		},
+	})
+}
+
 func TestAccIgnoreRule_invalid_operational_risk(t *testing.T) {
 	_, _, name := testutil.MkNames("ignore-rule-", "xray_ignore_rule")
 	expirationDate := time.Now().Add(time.Hour * 48)
@@ -473,6 +558,291 @@ func TestAccIgnoreRule_scopes_no_expiration_watches(t *testing.T) {
 	})
 }
 
+func TestAccIgnoreRule_on_expiry_recreate(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("ignore-rule-", "xray_ignore_rule")
+	expirationDate := time.Now().Add(time.Hour * 24 * 30)
+
+	config := util.ExecuteTemplate("TestAccIgnoreRule", `
+		resource "xray_ignore_rule" "{{ .name }}" {
+		  notes     = "fake notes"
+		  cves      = ["fake-cve"]
+		  on_expiry = "recreate"
+		  renew_for = "30d"
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", testCheckIgnoreRule),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "on_expiry", "recreate"),
+					resource.TestCheckResourceAttr(fqrn, "renew_for", "30d"),
+					resource.TestCheckResourceAttr(fqrn, "expiration_date", expirationDate.Format("2006-01-02")),
+					resource.TestCheckResourceAttr(fqrn, "is_expired", "false"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccIgnoreRule_invalid_on_expiry(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		attrs       string
+		expectError *regexp.Regexp
+	}{
+		{
+			name:        "recreate_without_renew_for",
+			attrs:       `on_expiry = "recreate"`,
+			expectError: regexp.MustCompile(`.*Attribute 'renew_for' must be set when 'on_expiry' is set to 'recreate'.*`),
+		},
+		{
+			name: "recreate_with_expiration_date",
+			attrs: `on_expiry       = "recreate"
+			        renew_for       = "30d"
+			        expiration_date = "2030-01-01"`,
+			expectError: regexp.MustCompile(`.*Attribute 'expiration_date' can't be set when 'on_expiry' is set to.*`),
+		},
+		{
+			name: "remove_with_expiration_date",
+			attrs: `on_expiry       = "remove"
+			        expiration_date = "2030-01-01"`,
+			expectError: regexp.MustCompile(`(?s).*Attribute 'expiration_date' can't be set when.*'on_expiry' is set to.*'remove'.*`),
+		},
+		{
+			name:        "renew_for_without_recreate",
+			attrs:       `renew_for = "30d"`,
+			expectError: regexp.MustCompile(`.*Attribute 'renew_for' can only be set when 'on_expiry' is set to.*`),
+		},
+		{
+			name: "invalid_renew_for",
+			attrs: `on_expiry = "recreate"
+			        renew_for = "30 days"`,
+			expectError: regexp.MustCompile(`.*Invalid Duration Format.*`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, name := testutil.MkNames("ignore-rule-", "xray_ignore_rule")
+
+			config := util.ExecuteTemplate("TestAccIgnoreRule", `
+				resource "xray_ignore_rule" "{{ .name }}" {
+				  notes = "fake notes"
+				  cves  = ["fake-cve"]
+				  {{ .attrs }}
+				}
+			`, map[string]interface{}{
+				"name":  name,
+				"attrs": tc.attrs,
+			})
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: tc.expectError,
+					},
+				},
+			})
+		})
+	}
+}
+
+// TestIgnoreRule_on_expiry_remove_expired covers an ignore rule in the state
+// which expired after being created with 'on_expiry' set to 'remove': the
+// configuration is still valid, the rule is dropped from the state, and it is
+// planned for creation again with an expiration date resolved again.
+func TestIgnoreRule_on_expiry_remove_expired(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && r.URL.Path == "/xray/api/v1/ignore_rules/expired-rule" {
+			expiresAt := time.Now().AddDate(0, 0, -1)
+			json.NewEncoder(w).Encode(xray.IgnoreRuleAPIModel{
+				ID:        "expired-rule",
+				IsExpired: true,
+				Notes:     "fake notes",
+				ExpiresAt: &expiresAt,
+				IgnoreFilters: xray.IgnoreFiltersAPIModel{
+					CVEs: []string{"fake-cve"},
+				},
+			})
+		}
+	}))
+	defer server.Close()
+
+	r := xray.NewIgnoreRuleResource()
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+		ProviderData: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+	}, &fwresource.ConfigureResponse{})
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	value := func(document string) tftypes.Value {
+		v, err := tftypes.ValueFromJSON([]byte(document), schemaResp.Schema.Type().TerraformType(ctx))
+		if err != nil {
+			t.Fatalf("failed to build the ignore rule value: %s", err)
+		}
+		return v
+	}
+
+	configValue := value(`{"notes": "fake notes", "cves": ["fake-cve"], "on_expiry": "remove", "expires_in": "30d"}`)
+	stateValue := value(fmt.Sprintf(`{
+		"id": "expired-rule",
+		"notes": "fake notes",
+		"cves": ["fake-cve"],
+		"on_expiry": "remove",
+		"expires_in": "30d",
+		"expiration_date": "%s",
+		"is_expired": false
+	}`, time.Now().AddDate(0, 0, -1).Format("2006-01-02")))
+
+	validateResp := fwresource.ValidateConfigResponse{}
+	r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, fwresource.ValidateConfigRequest{
+		Config: tfsdk.Config{Raw: configValue, Schema: schemaResp.Schema},
+	}, &validateResp)
+	if validateResp.Diagnostics.HasError() {
+		t.Fatalf("expected the configuration to stay valid after the expiration, got %v", validateResp.Diagnostics)
+	}
+
+	readResp := fwresource.ReadResponse{State: tfsdk.State{Raw: stateValue, Schema: schemaResp.Schema}}
+	r.Read(ctx, fwresource.ReadRequest{State: tfsdk.State{Raw: stateValue, Schema: schemaResp.Schema}}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", readResp.Diagnostics)
+	}
+	if !readResp.State.Raw.IsNull() {
+		t.Fatal("expected the expired rule to be removed from the state")
+	}
+	if readResp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("expected the expiry to be reported as a warning, got %v", readResp.Diagnostics)
+	}
+
+	plan := tfsdk.Plan{Raw: configValue, Schema: schemaResp.Schema}
+	planResp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Raw: configValue, Schema: schemaResp.Schema},
+		Plan:   plan,
+		State:  tfsdk.State{Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil), Schema: schemaResp.Schema},
+	}, &planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", planResp.Diagnostics)
+	}
+
+	var expirationDate string
+	planResp.Plan.GetAttribute(ctx, path.Root("expiration_date"), &expirationDate)
+	if expected := time.Now().AddDate(0, 0, 30).Format("2006-01-02"); expirationDate != expected {
+		t.Errorf("expected the rule to be planned expiring on %s, got %s", expected, expirationDate)
+	}
+}
+
+func TestAccIgnoreRule_expires_in(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("ignore-rule-", "xray_ignore_rule")
+	expirationDate := time.Now().Add(time.Hour * 720)
+
+	config := util.ExecuteTemplate("TestAccIgnoreRule", `
+		resource "xray_ignore_rule" "{{ .name }}" {
+		  notes      = "fake notes"
+		  cves       = ["fake-cve"]
+		  expires_in = "720h"
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", testCheckIgnoreRule),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "expires_in", "720h"),
+					resource.TestCheckResourceAttr(fqrn, "expiration_date", expirationDate.Format("2006-01-02")),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccIgnoreRule_invalid_expires_in(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		attrs       string
+		expectError *regexp.Regexp
+	}{
+		{
+			name:        "invalid_duration",
+			attrs:       `expires_in = "a month"`,
+			expectError: regexp.MustCompile(`.*Invalid Duration Format.*`),
+		},
+		{
+			name:        "negative_duration",
+			attrs:       `expires_in = "-24h"`,
+			expectError: regexp.MustCompile(`.*Value must be a positive duration.*`),
+		},
+		{
+			name: "with_expiration_date",
+			attrs: `expires_in      = "30d"
+			        expiration_date = "2030-01-01"`,
+			expectError: regexp.MustCompile(`.*Invalid Attribute Combination.*`),
+		},
+		{
+			name: "with_on_expiry_recreate",
+			attrs: `expires_in = "30d"
+			        on_expiry  = "recreate"
+			        renew_for  = "30d"`,
+			expectError: regexp.MustCompile(`.*Attribute 'expires_in' can't be set when 'on_expiry' is set to.*`),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, name := testutil.MkNames("ignore-rule-", "xray_ignore_rule")
+
+			config := util.ExecuteTemplate("TestAccIgnoreRule", `
+				resource "xray_ignore_rule" "{{ .name }}" {
+				  notes = "fake notes"
+				  cves  = ["fake-cve"]
+				  {{ .attrs }}
+				}
+			`, map[string]interface{}{
+				"name":  name,
+				"attrs": tc.attrs,
+			})
+
+			resource.Test(t, resource.TestCase{
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config:      config,
+						ExpectError: tc.expectError,
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestAccIgnoreRule_docker_layers(t *testing.T) {
 	_, fqrn, name := testutil.MkNames("ignore-rule-", "xray_ignore_rule")
 	expirationDate := time.Now().Add(time.Hour * 48)
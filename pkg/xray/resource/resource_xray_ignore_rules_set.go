@@ -0,0 +1,691 @@
+package xray
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+var _ resource.Resource = &IgnoreRulesSetResource{}
+var _ resource.ResourceWithModifyPlan = &IgnoreRulesSetResource{}
+
+func NewIgnoreRulesSetResource() resource.Resource {
+	return &IgnoreRulesSetResource{
+		TypeName: "xray_ignore_rules_set",
+	}
+}
+
+type IgnoreRulesSetResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+func (r *IgnoreRulesSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+type IgnoreRulesSetResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ProjectKey  types.String `tfsdk:"project_key"`
+	Parallelism types.Int64  `tfsdk:"parallelism"`
+	Rules       types.Map    `tfsdk:"rules"`
+	RuleIDs     types.Map    `tfsdk:"rule_ids"`
+}
+
+type IgnoreRulesSetRuleResourceModel struct {
+	Notes      types.String `tfsdk:"notes"`
+	ExpiredAt  types.String `tfsdk:"expiration_date"`
+	Watches    types.Set    `tfsdk:"watches"`
+	Components types.Set    `tfsdk:"components"`
+}
+
+var ignoreRulesSetRuleResourceModelAttributeTypes types.ObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"notes":           types.StringType,
+		"expiration_date": types.StringType,
+		"watches":         types.SetType{ElemType: types.StringType},
+		"components":      types.SetType{ElemType: nameVersionSetResourceModelAttributeTypes},
+	},
+}
+
+var cveRegex = regexp.MustCompile(`^CVE-\d{4}-\d+$`)
+
+func (m IgnoreRulesSetRuleResourceModel) toAPIModel(ctx context.Context, vulnerability string, apiModel *IgnoreRuleAPIModel) (ds diag.Diagnostics) {
+	var expiresAt *time.Time
+	if m.ExpiredAt.ValueString() != "" {
+		parsedTime, err := time.ParseInLocation("2006-01-02", m.ExpiredAt.ValueString(), time.Local)
+		if err != nil {
+			ds.AddError(
+				"failed to parse date/time string",
+				err.Error(),
+			)
+		}
+		expiresAt = &parsedTime
+	}
+
+	var watches []string
+	ds.Append(m.Watches.ElementsAs(ctx, &watches, false)...)
+
+	components := lo.Map(
+		m.Components.Elements(),
+		unpackFilterNameVersion,
+	)
+
+	ignoreFilters := IgnoreFiltersAPIModel{
+		Watches:    watches,
+		Components: components,
+	}
+	if cveRegex.MatchString(vulnerability) {
+		ignoreFilters.CVEs = []string{vulnerability}
+	} else {
+		ignoreFilters.Vulnerabilities = []string{vulnerability}
+	}
+
+	*apiModel = IgnoreRuleAPIModel{
+		Notes:         m.Notes.ValueString(),
+		ExpiresAt:     expiresAt,
+		IgnoreFilters: ignoreFilters,
+	}
+
+	return
+}
+
+func (m *IgnoreRulesSetRuleResourceModel) fromAPIModel(ctx context.Context, apiModel IgnoreRuleAPIModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	m.Notes = types.StringValue(apiModel.Notes)
+
+	expiresAt := types.StringNull()
+	if apiModel.ExpiresAt != nil {
+		expiresAt = types.StringValue(apiModel.ExpiresAt.Local().Format("2006-01-02"))
+	}
+	m.ExpiredAt = expiresAt
+
+	watches := types.SetNull(types.StringType)
+	if len(apiModel.IgnoreFilters.Watches) > 0 {
+		w, d := types.SetValueFrom(ctx, types.StringType, apiModel.IgnoreFilters.Watches)
+		if d != nil {
+			diags.Append(d...)
+		}
+		watches = w
+	}
+	m.Watches = watches
+
+	components := types.SetNull(nameVersionSetResourceModelAttributeTypes)
+	if len(apiModel.IgnoreFilters.Components) > 0 {
+		c, d := packNameVersion(apiModel.IgnoreFilters.Components)
+		if d != nil {
+			diags.Append(d...)
+		}
+		components = c
+	}
+	m.Components = components
+
+	return diags
+}
+
+func (m IgnoreRulesSetRuleResourceModel) equal(other IgnoreRulesSetRuleResourceModel) bool {
+	return m.Notes.Equal(other.Notes) &&
+		m.ExpiredAt.Equal(other.ExpiredAt) &&
+		m.Watches.Equal(other.Watches) &&
+		m.Components.Equal(other.Components)
+}
+
+func (m IgnoreRulesSetResourceModel) rules(ctx context.Context) (map[string]IgnoreRulesSetRuleResourceModel, map[string]string, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	rules := map[string]IgnoreRulesSetRuleResourceModel{}
+	if !m.Rules.IsNull() && !m.Rules.IsUnknown() {
+		diags.Append(m.Rules.ElementsAs(ctx, &rules, false)...)
+	}
+
+	ruleIDs := map[string]string{}
+	if !m.RuleIDs.IsNull() && !m.RuleIDs.IsUnknown() {
+		diags.Append(m.RuleIDs.ElementsAs(ctx, &ruleIDs, false)...)
+	}
+
+	return rules, ruleIDs, diags
+}
+
+func (m *IgnoreRulesSetResourceModel) setRules(ctx context.Context, rules map[string]IgnoreRulesSetRuleResourceModel, ruleIDs map[string]string) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	rulesValue, d := types.MapValueFrom(ctx, ignoreRulesSetRuleResourceModelAttributeTypes, rules)
+	if d != nil {
+		diags.Append(d...)
+	}
+	m.Rules = rulesValue
+
+	ruleIDsValue, d := types.MapValueFrom(ctx, types.StringType, ruleIDs)
+	if d != nil {
+		diags.Append(d...)
+	}
+	m.RuleIDs = ruleIDsValue
+
+	return diags
+}
+
+// forEachConcurrently calls fn for every key with at most parallelism calls
+// running at the same time, and returns the results and errors by key. Once
+// the context is cancelled, the keys not started yet fail with the context
+// error.
+func forEachConcurrently[T any](ctx context.Context, keys []string, parallelism int, fn func(key string) (T, error)) (map[string]T, map[string]error) {
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		results = map[string]T{}
+		errs    = map[string]error{}
+	)
+
+	sem := make(chan struct{}, max(parallelism, 1))
+	for index, key := range keys {
+		select {
+		case <-ctx.Done():
+		case sem <- struct{}{}:
+		}
+
+		if err := ctx.Err(); err != nil {
+			wg.Wait()
+			for _, key := range keys[index:] {
+				errs[key] = err
+			}
+			return results, errs
+		}
+
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result, err := fn(key)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[key] = err
+				return
+			}
+			results[key] = result
+		}(key)
+	}
+	wg.Wait()
+
+	return results, errs
+}
+
+// errorsSummary formats the errors by key in a stable order to be used in
+// diagnostics.
+func errorsSummary(errs map[string]error) string {
+	keys := lo.Keys(errs)
+	slices.Sort(keys)
+
+	return strings.Join(
+		lo.Map(keys, func(key string, _ int) string {
+			return fmt.Sprintf("%s: %s", key, errs[key])
+		}),
+		"\n",
+	)
+}
+
+func (r *IgnoreRulesSetResource) createRules(ctx context.Context, projectKey string, parallelism int, keys []string, rules map[string]IgnoreRulesSetRuleResourceModel) (map[string]IgnoreRuleAPIModel, map[string]error) {
+	return forEachConcurrently(ctx, keys, parallelism, func(key string) (IgnoreRuleAPIModel, error) {
+		var ignoreRule IgnoreRuleAPIModel
+		if d := rules[key].toAPIModel(ctx, key, &ignoreRule); d.HasError() {
+			return ignoreRule, fmt.Errorf("%s: %s", d[0].Summary(), d[0].Detail())
+		}
+
+		request, err := getRestyRequest(r.ProviderData.Client, projectKey)
+		if err != nil {
+			return ignoreRule, err
+		}
+
+		return createIgnoreRule(request, ignoreRule)
+	})
+}
+
+func (r *IgnoreRulesSetResource) deleteRules(ctx context.Context, projectKey string, parallelism int, ids []string) map[string]error {
+	_, errs := forEachConcurrently(ctx, ids, parallelism, func(id string) (struct{}, error) {
+		request, err := getRestyRequest(r.ProviderData.Client, projectKey)
+		if err != nil {
+			return struct{}{}, err
+		}
+
+		return struct{}{}, deleteIgnoreRule(request, id)
+	})
+
+	return errs
+}
+
+func (r *IgnoreRulesSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: lo.Assign(
+			projectKeySchemaAttrs(true, ""),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"parallelism": schema.Int64Attribute{
+					Optional: true,
+					Computed: true,
+					Default:  int64default.StaticInt64(5),
+					Validators: []validator.Int64{
+						int64validator.Between(1, 20),
+					},
+					Description: "Maximum number of ignore rules created or deleted at the same time. Default value is `5`.",
+				},
+				"rules": schema.MapNestedAttribute{
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"notes": schema.StringAttribute{
+								Required:    true,
+								Description: "Notes of the ignore rule, e.g. the justification of the accepted risk.",
+							},
+							"expiration_date": schema.StringAttribute{
+								Optional: true,
+								Validators: []validator.String{
+									stringvalidator.RegexMatches(
+										regexp.MustCompile(`^\d{4}-(0[1-9]|1[012])-(0[1-9]|[12][0-9]|3[0-1])$`),
+										"Date must be in YYYY-MM-DD format",
+									),
+								},
+								Description: "The ignore rule will be active until the expiration date. Vaule assumes to be in local timezone. Ensure client and server time zones match.",
+							},
+							"watches": schema.SetAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Validators: []validator.Set{
+									setvalidator.SizeAtLeast(1),
+								},
+								Description: "List of specific watches to ignore. Omit to apply to all.",
+							},
+							"components": schema.SetNestedAttribute{
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"name": schema.StringAttribute{
+											Required:    true,
+											Description: "Name of the component",
+										},
+										"version": schema.StringAttribute{
+											Optional: true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtLeast(1),
+											},
+											Description: "Version of the component",
+										},
+									},
+								},
+								Optional: true,
+								Validators: []validator.Set{
+									setvalidator.SizeAtLeast(1),
+								},
+								Description: "List of specific components to ignore. Omit to apply to all.",
+							},
+						},
+					},
+					Required: true,
+					Validators: []validator.Map{
+						mapvalidator.SizeAtLeast(1),
+						mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+					},
+					Description: "Ignore rules by CVE (e.g. `CVE-2021-44228`) or Xray vulnerability ID (e.g. `XRAY-123456`). Keys matching the CVE format are ignored as `cves`, other keys as `vulnerabilities`.",
+				},
+				"rule_ids": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    true,
+					Description: "IDs of the ignore rules in Xray, by CVE or Xray vulnerability ID. The IDs of unchanged entries stay known when other entries change.",
+				},
+			},
+		),
+		Description: "Provides a set of Xray ignore rules managed as a single resource, one ignore rule per CVE or Xray vulnerability ID. Only the ignore rules which are added, changed or removed are created or deleted in Xray. As Xray can't modify an ignore rule, a changed entry is swapped for a new ignore rule.",
+	}
+}
+
+func (r *IgnoreRulesSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+func (r *IgnoreRulesSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan IgnoreRulesSetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, _, d := plan.rules(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+	parallelism := int(plan.Parallelism.ValueInt64())
+
+	created, errs := r.createRules(ctx, projectKey, parallelism, lo.Keys(rules), rules)
+	if len(errs) > 0 {
+		utilfw.UnableToCreateResourceError(resp, errorsSummary(errs))
+
+		// Roll back so no ignore rule is left behind outside of the state.
+		ids := lo.MapToSlice(created, func(_ string, ignoreRule IgnoreRuleAPIModel) string {
+			return ignoreRule.ID
+		})
+		if deleteErrs := r.deleteRules(ctx, projectKey, parallelism, ids); len(deleteErrs) > 0 {
+			resp.Diagnostics.AddError(
+				"failed to roll back ignore rules creation",
+				fmt.Sprintf("Ignore rules were created but could not be deleted, remove them manually:\n%s", errorsSummary(deleteErrs)),
+			)
+		}
+		return
+	}
+
+	ruleIDs := map[string]string{}
+	for key, ignoreRule := range created {
+		var rule IgnoreRulesSetRuleResourceModel
+		resp.Diagnostics.Append(rule.fromAPIModel(ctx, ignoreRule)...)
+		rules[key] = rule
+		ruleIDs[key] = ignoreRule.ID
+	}
+
+	resp.Diagnostics.Append(plan.setRules(ctx, rules, ruleIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := lo.Values(ruleIDs)
+	slices.Sort(ids)
+	hash := sha256.Sum256([]byte(strings.Join(ids, ",")))
+	plan.ID = types.StringValue(fmt.Sprintf("%x", hash))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *IgnoreRulesSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state IgnoreRulesSetResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, ruleIDs, d := state.rules(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A nil ignore rule means it no longer exists in Xray.
+	ignoreRules, errs := forEachConcurrently(ctx, lo.Keys(ruleIDs), int(state.Parallelism.ValueInt64()), func(key string) (*IgnoreRuleAPIModel, error) {
+		request, err := getRestyRequest(r.ProviderData.Client, state.ProjectKey.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		var ignoreRule IgnoreRuleAPIModel
+		response, err := request.
+			SetPathParam("id", ruleIDs[key]).
+			SetResult(&ignoreRule).
+			Get(IgnoreRuleEndpoint)
+		if err != nil {
+			return nil, err
+		}
+		if response.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		if response.IsError() {
+			return nil, fmt.Errorf("%s", response.String())
+		}
+
+		return &ignoreRule, nil
+	})
+	if len(errs) > 0 {
+		utilfw.UnableToRefreshResourceError(resp, errorsSummary(errs))
+		return
+	}
+
+	for key, ignoreRule := range ignoreRules {
+		if ignoreRule == nil {
+			delete(rules, key)
+			delete(ruleIDs, key)
+			continue
+		}
+
+		var rule IgnoreRulesSetRuleResourceModel
+		resp.Diagnostics.Append(rule.fromAPIModel(ctx, *ignoreRule)...)
+		rules[key] = rule
+	}
+
+	resp.Diagnostics.Append(state.setRules(ctx, rules, ruleIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update reconciles the ignore rules with the plan. Added and changed entries
+// are created first, then removed entries and the previous ignore rules of
+// changed entries are deleted. Entries which failed are left as they were in
+// the state so the next apply retries them.
+func (r *IgnoreRulesSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan, state IgnoreRulesSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planRules, _, d := plan.rules(ctx)
+	resp.Diagnostics.Append(d...)
+	stateRules, stateRuleIDs, d := state.rules(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectKey := plan.ProjectKey.ValueString()
+	parallelism := int(plan.Parallelism.ValueInt64())
+
+	var toCreate, toRemove []string
+	for key, rule := range planRules {
+		stateRule, ok := stateRules[key]
+		if !ok || !stateRule.equal(rule) {
+			toCreate = append(toCreate, key)
+		}
+	}
+	for key := range stateRules {
+		if _, ok := planRules[key]; !ok {
+			toRemove = append(toRemove, key)
+		}
+	}
+
+	rules := stateRules
+	ruleIDs := stateRuleIDs
+
+	created, createErrs := r.createRules(ctx, projectKey, parallelism, toCreate, planRules)
+
+	// Ignore rules to delete, with the key of the entry they belong to.
+	toDelete := map[string]string{}
+	for _, key := range toRemove {
+		toDelete[ruleIDs[key]] = key
+	}
+	for key := range created {
+		if id, ok := ruleIDs[key]; ok {
+			toDelete[id] = key
+		}
+	}
+
+	deleteErrs := r.deleteRules(ctx, projectKey, parallelism, lo.Keys(toDelete))
+
+	// Ignore rules created for an entry whose previous ignore rule could not
+	// be deleted are deleted again, so the entry stays as it was.
+	var rollback []string
+	for id := range deleteErrs {
+		key := toDelete[id]
+		if ignoreRule, ok := created[key]; ok {
+			rollback = append(rollback, ignoreRule.ID)
+			delete(created, key)
+		}
+	}
+	rollbackErrs := r.deleteRules(ctx, projectKey, parallelism, rollback)
+
+	for id, key := range toDelete {
+		if _, failed := deleteErrs[id]; !failed && lo.Contains(toRemove, key) {
+			delete(rules, key)
+			delete(ruleIDs, key)
+		}
+	}
+	for key, ignoreRule := range created {
+		var rule IgnoreRulesSetRuleResourceModel
+		resp.Diagnostics.Append(rule.fromAPIModel(ctx, ignoreRule)...)
+		rules[key] = rule
+		ruleIDs[key] = ignoreRule.ID
+	}
+
+	if len(createErrs) > 0 {
+		utilfw.UnableToUpdateResourceError(resp, fmt.Sprintf("failed to create ignore rules:\n%s", errorsSummary(createErrs)))
+	}
+	if len(deleteErrs) > 0 {
+		utilfw.UnableToUpdateResourceError(resp, fmt.Sprintf("failed to delete ignore rules:\n%s", errorsSummary(deleteErrs)))
+	}
+	if len(rollbackErrs) > 0 {
+		resp.Diagnostics.AddError(
+			"failed to roll back ignore rules update",
+			fmt.Sprintf("Ignore rules were created but could not be deleted, remove them manually:\n%s", errorsSummary(rollbackErrs)),
+		)
+	}
+
+	resp.Diagnostics.Append(plan.setRules(ctx, rules, ruleIDs)...)
+	plan.ID = state.ID
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// ModifyPlan keeps the IDs of the ignore rules of unchanged entries, so only
+// the IDs of added and changed entries are unknown until applied.
+func (r *IgnoreRulesSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being created or destroyed, there are no IDs to keep.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state IgnoreRulesSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Rules.IsUnknown() {
+		return
+	}
+
+	// Entries not fully known yet are planned as changed.
+	planRules, _, d := plan.rules(ctx)
+	if d.HasError() {
+		return
+	}
+
+	stateRules, stateRuleIDs, d := state.rules(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleIDs := map[string]attr.Value{}
+	for key, rule := range planRules {
+		stateRule, ok := stateRules[key]
+		id, found := stateRuleIDs[key]
+		if ok && found && stateRule.equal(rule) {
+			ruleIDs[key] = types.StringValue(id)
+		} else {
+			ruleIDs[key] = types.StringUnknown()
+		}
+	}
+
+	ruleIDsValue, d := types.MapValue(types.StringType, ruleIDs)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rule_ids"), ruleIDsValue)...)
+}
+
+func (r *IgnoreRulesSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state IgnoreRulesSetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, ruleIDs, d := state.rules(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	errs := r.deleteRules(ctx, state.ProjectKey.ValueString(), int(state.Parallelism.ValueInt64()), lo.Values(ruleIDs))
+	if len(errs) > 0 {
+		utilfw.UnableToDeleteResourceError(resp, errorsSummary(errs))
+
+		// Keep only the ignore rules which could not be deleted in the state.
+		for key, id := range ruleIDs {
+			if _, failed := errs[id]; !failed {
+				delete(rules, key)
+				delete(ruleIDs, key)
+			}
+		}
+		resp.Diagnostics.Append(state.setRules(ctx, rules, ruleIDs)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
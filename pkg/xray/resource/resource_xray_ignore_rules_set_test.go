@@ -0,0 +1,261 @@
+package xray_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+	xray "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+)
+
+func TestAccIgnoreRulesSet_full(t *testing.T) {
+	_, fqrn, name := testutil.MkNames("ignore-rules-set-", "xray_ignore_rules_set")
+	expirationDate := time.Now().Add(time.Hour * 48)
+
+	config := util.ExecuteTemplate("TestAccIgnoreRulesSet", `
+		resource "xray_ignore_rules_set" "{{ .name }}" {
+		  rules = {
+		    "CVE-2021-44228" = {
+		      notes           = "fake notes"
+		      expiration_date = "{{ .expirationDate }}"
+		      components      = [{
+		        name    = "fake-component"
+		        version = "1.0.0"
+		      }]
+		    }
+		    "CVE-2022-22965" = {
+		      notes = "fake notes"
+		    }
+		  }
+		}
+	`, map[string]interface{}{
+		"name":           name,
+		"expirationDate": expirationDate.Format("2006-01-02"),
+	})
+
+	updatedConfig := util.ExecuteTemplate("TestAccIgnoreRulesSet", `
+		resource "xray_ignore_rules_set" "{{ .name }}" {
+		  rules = {
+		    "CVE-2021-44228" = {
+		      notes           = "fake notes"
+		      expiration_date = "{{ .expirationDate }}"
+		      components      = [{
+		        name    = "fake-component"
+		        version = "1.0.0"
+		      }]
+		    }
+		    "CVE-2023-44487" = {
+		      notes = "updated notes"
+		    }
+		  }
+		}
+	`, map[string]interface{}{
+		"name":           name,
+		"expirationDate": expirationDate.Format("2006-01-02"),
+	})
+
+	changedConfig := util.ExecuteTemplate("TestAccIgnoreRulesSet", `
+		resource "xray_ignore_rules_set" "{{ .name }}" {
+		  rules = {
+		    "CVE-2021-44228" = {
+		      notes           = "changed notes"
+		      expiration_date = "{{ .expirationDate }}"
+		      components      = [{
+		        name    = "fake-component"
+		        version = "1.0.0"
+		      }]
+		    }
+		    "CVE-2023-44487" = {
+		      notes = "updated notes"
+		    }
+		  }
+		}
+	`, map[string]interface{}{
+		"name":           name,
+		"expirationDate": expirationDate.Add(time.Hour * 24).Format("2006-01-02"),
+	})
+
+	var unchangedID, addedID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "rule_ids.CVE-2021-44228", testCheckIgnoreRule),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "id"),
+					resource.TestCheckResourceAttr(fqrn, "parallelism", "5"),
+					resource.TestCheckResourceAttr(fqrn, "rules.%", "2"),
+					resource.TestCheckResourceAttr(fqrn, "rules.CVE-2021-44228.notes", "fake notes"),
+					resource.TestCheckResourceAttr(fqrn, "rules.CVE-2021-44228.expiration_date", expirationDate.Format("2006-01-02")),
+					resource.TestCheckResourceAttr(fqrn, "rules.CVE-2021-44228.components.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "rules.CVE-2021-44228.components.0.name", "fake-component"),
+					resource.TestCheckResourceAttr(fqrn, "rules.CVE-2021-44228.components.0.version", "1.0.0"),
+					resource.TestCheckResourceAttr(fqrn, "rule_ids.%", "2"),
+					resource.TestCheckResourceAttrSet(fqrn, "rule_ids.CVE-2022-22965"),
+					func(s *terraform.State) error {
+						unchangedID = s.RootModule().Resources[fqrn].Primary.Attributes["rule_ids.CVE-2021-44228"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rules.%", "2"),
+					resource.TestCheckResourceAttr(fqrn, "rules.CVE-2023-44487.notes", "updated notes"),
+					resource.TestCheckNoResourceAttr(fqrn, "rules.CVE-2022-22965.notes"),
+					resource.TestCheckResourceAttr(fqrn, "rule_ids.%", "2"),
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources[fqrn].Primary.Attributes["rule_ids.CVE-2021-44228"]
+						if id != unchangedID {
+							return fmt.Errorf("expected unchanged ignore rule to keep ID %s, got %s", unchangedID, id)
+						}
+						addedID = s.RootModule().Resources[fqrn].Primary.Attributes["rule_ids.CVE-2023-44487"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: changedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(fqrn, tfjsonpath.New("rule_ids").AtMapKey("CVE-2021-44228")),
+						plancheck.ExpectKnownValue(fqrn, tfjsonpath.New("rule_ids").AtMapKey("CVE-2023-44487"), knownvalue.NotNull()),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rules.CVE-2021-44228.notes", "changed notes"),
+					resource.TestCheckResourceAttr(fqrn, "rules.CVE-2021-44228.expiration_date", expirationDate.Add(time.Hour*24).Format("2006-01-02")),
+					resource.TestCheckResourceAttr(fqrn, "rule_ids.%", "2"),
+					func(s *terraform.State) error {
+						attributes := s.RootModule().Resources[fqrn].Primary.Attributes
+						if id := attributes["rule_ids.CVE-2021-44228"]; id == unchangedID {
+							return fmt.Errorf("expected changed ignore rule to get a new ID, got %s", id)
+						}
+						if id := attributes["rule_ids.CVE-2023-44487"]; id != addedID {
+							return fmt.Errorf("expected unchanged ignore rule to keep ID %s, got %s", addedID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccIgnoreRulesSet_invalid_parallelism(t *testing.T) {
+	_, _, name := testutil.MkNames("ignore-rules-set-", "xray_ignore_rules_set")
+
+	config := util.ExecuteTemplate("TestAccIgnoreRulesSet", `
+		resource "xray_ignore_rules_set" "{{ .name }}" {
+		  parallelism = 0
+		  rules = {
+		    "CVE-2021-44228" = {
+		      notes = "fake notes"
+		    }
+		  }
+		}
+	`, map[string]interface{}{
+		"name": name,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Attribute parallelism value must be between 1 and 20.*`),
+			},
+		},
+	})
+}
+
+func TestIgnoreRulesSet_planKeepsUnchangedRuleIDs(t *testing.T) {
+	ctx := context.Background()
+
+	r := xray.NewIgnoreRulesSetResource()
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	value := func(document string) tftypes.Value {
+		v, err := tftypes.ValueFromJSON([]byte(document), schemaResp.Schema.Type().TerraformType(ctx))
+		if err != nil {
+			t.Fatalf("failed to build the ignore rules set value: %s", err)
+		}
+		return v
+	}
+
+	stateValue := value(`{
+		"id": "fake-id",
+		"parallelism": 5,
+		"rules": {
+			"CVE-2021-44228": {"notes": "fake notes", "expiration_date": "2030-01-01"},
+			"CVE-2022-22965": {"notes": "fake notes"},
+			"CVE-2023-44487": {"notes": "fake notes"}
+		},
+		"rule_ids": {"CVE-2021-44228": "rule-1", "CVE-2022-22965": "rule-2", "CVE-2023-44487": "rule-3"}
+	}`)
+	planValue := value(`{
+		"id": "fake-id",
+		"parallelism": 5,
+		"rules": {
+			"CVE-2021-44228": {"notes": "fake notes", "expiration_date": "2030-01-02"},
+			"CVE-2022-22965": {"notes": "fake notes"},
+			"CVE-2024-3094": {"notes": "fake notes"}
+		}
+	}`)
+
+	req := fwresource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: planValue},
+		Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: planValue},
+		State:  tfsdk.State{Schema: schemaResp.Schema, Raw: stateValue},
+	}
+	resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var ruleIDs types.Map
+	resp.Plan.GetAttribute(ctx, path.Root("rule_ids"), &ruleIDs)
+	expected := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"CVE-2021-44228": types.StringUnknown(),
+		"CVE-2022-22965": types.StringValue("rule-2"),
+		"CVE-2024-3094":  types.StringUnknown(),
+	})
+	if !ruleIDs.Equal(expected) {
+		t.Errorf("expected rule_ids %s, got %s", expected, ruleIDs)
+	}
+}
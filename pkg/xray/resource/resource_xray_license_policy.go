@@ -19,6 +19,8 @@ import (
 )
 
 var _ resource.Resource = &LicensePolicyResource{}
+var _ resource.ResourceWithModifyPlan = &LicensePolicyResource{}
+var _ resource.ResourceWithUpgradeState = &LicensePolicyResource{}
 
 func NewLicensePolicyResource() resource.Resource {
 	return &LicensePolicyResource{
@@ -229,7 +231,7 @@ var licenseRuleAttrTypes = map[string]attr.Type{
 	"actions":  types.SetType{ElemType: licenseActionsSetElementType},
 }
 
-var licenseRuleSetElementType = types.ObjectType{
+var licenseRuleElementType = types.ObjectType{
 	AttrTypes: licenseRuleAttrTypes,
 }
 
@@ -266,6 +268,28 @@ var licensePolicyCriteriaAttrs = map[string]schema.Attribute{
 
 var licensePolicyCriteriaBlocks = map[string]schema.Block{}
 
+// licensePolicyCriteriaAttrsV1 are the criteria of the schema version 1,
+// which must not follow the changes of the current criteria to read the prior
+// state.
+var licensePolicyCriteriaAttrsV1 = map[string]schema.Attribute{
+	"banned_licenses": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	},
+	"allowed_licenses": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	},
+	"allow_unknown": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+	},
+	"multi_license_permissive": schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+	},
+}
+
 var licensePolicyActionsAttrs = lo.Assign(
 	commonActionsAttrs,
 	map[string]schema.Attribute{
@@ -283,7 +307,7 @@ var licensePolicyActionsAttrs = lo.Assign(
 
 func (r *LicensePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Version:    1,
+		Version:    2,
 		Attributes: policySchemaAttrs,
 		Blocks:     policyBlocks(licensePolicyCriteriaAttrs, licensePolicyCriteriaBlocks, licensePolicyActionsAttrs, commonActionsBlocks),
 		Description: "Creates an Xray policy using V2 of the underlying APIs. Please note: " +
@@ -291,6 +315,10 @@ func (r *LicensePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *LicensePolicyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return policyStateUpgraders(policyBlocksV1(licensePolicyCriteriaAttrsV1, map[string]schema.Block{}, licensePolicyActionsAttrs, commonActionsBlocks), licenseRuleElementType)
+}
+
 func (r *LicensePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -315,6 +343,10 @@ func (r *LicensePolicyResource) Delete(ctx context.Context, req resource.DeleteR
 	r.PolicyResource.Delete(ctx, req, resp)
 }
 
+func (r *LicensePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.PolicyResource.ModifyPlan(ctx, req, resp)
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *LicensePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	r.PolicyResource.ImportState(ctx, req, resp)
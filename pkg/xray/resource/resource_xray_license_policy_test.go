@@ -113,6 +113,80 @@ func TestAccLicensePolicy_UpgradeFromSDKv2(t *testing.T) {
 	})
 }
 
+func TestAccLicensePolicy_UpgradeRulesFromSet(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_license_policy")
+
+	testData := sdk.MergeMaps(testDataLicense)
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-license-policy-%d", testutil.RandomInt())
+
+	config := util.ExecuteTemplate(fqrn, `
+	resource "xray_license_policy" "{{ .resource_name }}" {
+		name        = "{{ .policy_name }}"
+		description = "{{ .policy_description }}"
+		type        = "license"
+
+		rule {
+			name     = "rule-banned"
+			priority = 1
+			criteria {
+				banned_licenses = ["{{ .license_0 }}"]
+				allow_unknown   = {{ .allow_unknown }}
+			}
+			actions {
+				custom_severity = "{{ .custom_severity }}"
+				block_download {
+					unscanned = {{ .block_unscanned }}
+					active    = {{ .block_active }}
+				}
+			}
+		}
+
+		rule {
+			name     = "rule-allowed"
+			priority = 2
+			criteria {
+				allowed_licenses         = ["{{ .license_1 }}"]
+				multi_license_permissive = {{ .multi_license_permissive }}
+			}
+			actions {
+				block_download {
+					active = false
+				}
+			}
+		}
+	}`, testData)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"xray": {
+						Source:            "jfrog/xray",
+						VersionConstraint: "3.0.7",
+					},
+				},
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "rule.#", "2"),
+			},
+			{
+				Config:                   config,
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rule.0.name", "rule-banned"),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.name", "rule-allowed"),
+				),
+			},
+		},
+	})
+}
+
 // License policy criteria are different from the security policy criteria
 // Test will try to post a new license policy with incorrect body of security policy
 // with specified cvss_range. The function unpackLicenseCriteria will ignore all the
@@ -22,7 +22,8 @@ var _ resource.Resource = &LicensesReportResource{}
 func NewLicensesReportResource() resource.Resource {
 	return &LicensesReportResource{
 		ReportResource: ReportResource{
-			TypeName: "xray_licenses_report",
+			TypeName:   "xray_licenses_report",
+			ReportType: "licenses",
 		},
 	}
 }
@@ -75,6 +76,35 @@ func (r *LicensesReportResource) toFiltersAPIModel(ctx context.Context, filtersE
 	return filters, diags
 }
 
+var licensesFiltersAttrTypes = map[string]attr.Type{
+	"component":        types.StringType,
+	"artifact":         types.StringType,
+	"unknown":          types.BoolType,
+	"unrecognized":     types.BoolType,
+	"license_names":    types.SetType{ElemType: types.StringType},
+	"license_patterns": types.SetType{ElemType: types.StringType},
+	"scan_date":        types.SetType{ElemType: types.ObjectType{AttrTypes: startAndEndDateAttrTypes}},
+}
+
+func (r *LicensesReportResource) fromFiltersAPIModel(ctx context.Context, filters *FiltersAPIModel, priorAttrs map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(
+		licensesFiltersAttrTypes,
+		map[string]attr.Value{
+			"component":        stringValueOrNull(filters.Component),
+			"artifact":         stringValueOrNull(filters.Artifact),
+			"unknown":          types.BoolValue(filters.Unknown),
+			"unrecognized":     types.BoolValue(filters.Unrecognized),
+			"license_names":    stringSetValue(filters.LicenseNames),
+			"license_patterns": stringSetValue(filters.LicensePatterns),
+			"scan_date":        startAndEndDateSetValue(filters.ScanDate),
+		},
+	)
+}
+
+func (r LicensesReportResource) fromAPIModel(ctx context.Context, report ReportAPIModel, state *ReportResourceModel) diag.Diagnostics {
+	return state.fromAPIModel(ctx, report, r.fromFiltersAPIModel)
+}
+
 func (r LicensesReportResource) toAPIModel(ctx context.Context, plan ReportResourceModel, report *ReportAPIModel) diag.Diagnostics {
 	return plan.toAPIModel(ctx, report, r.toFiltersAPIModel)
 }
@@ -186,15 +216,19 @@ func (r *LicensesReportResource) Configure(ctx context.Context, req resource.Con
 }
 
 func (r *LicensesReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	r.ReportResource.Create(ctx, "licenses", r.toAPIModel, req, resp)
+	r.ReportResource.Create(ctx, r.toAPIModel, req, resp)
 }
 
 func (r *LicensesReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	r.ReportResource.Read(ctx, req, resp)
+	r.ReportResource.Read(ctx, r.fromAPIModel, req, resp)
 }
 
 func (r *LicensesReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	r.ReportResource.Update(ctx, "licenses", r.toAPIModel, req, resp)
+	r.ReportResource.Update(ctx, req, resp)
+}
+
+func (r *LicensesReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ReportResource.ImportState(ctx, req, resp)
 }
 
 func (r *LicensesReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
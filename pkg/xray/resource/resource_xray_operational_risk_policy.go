@@ -19,6 +19,8 @@ import (
 )
 
 var _ resource.Resource = &OperationalRiskPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &OperationalRiskPolicyResource{}
+var _ resource.ResourceWithUpgradeState = &OperationalRiskPolicyResource{}
 
 func NewOperationalRiskPolicyResource() resource.Resource {
 	return &OperationalRiskPolicyResource{
@@ -156,6 +158,50 @@ var opRiskPolicyCriteriaBlocks = map[string]schema.Block{
 	},
 }
 
+// opRiskPolicyCriteriaAttrsV1 and opRiskPolicyCriteriaBlocksV1 are the
+// criteria of the schema version 1, which must not follow the changes of the
+// current criteria to read the prior state.
+var opRiskPolicyCriteriaAttrsV1 = map[string]schema.Attribute{
+	"op_risk_min_risk": schema.StringAttribute{
+		Optional: true,
+	},
+}
+
+var opRiskPolicyCriteriaBlocksV1 = map[string]schema.Block{
+	"op_risk_custom": schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"use_and_condition": schema.BoolAttribute{
+					Required: true,
+				},
+				"is_eol": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"release_date_greater_than_months": schema.Int64Attribute{
+					Optional: true,
+				},
+				"newer_versions_greater_than": schema.Int64Attribute{
+					Optional: true,
+				},
+				"release_cadence_per_year_less_than": schema.Int64Attribute{
+					Optional: true,
+				},
+				"commits_less_than": schema.Int64Attribute{
+					Optional: true,
+				},
+				"committers_less_than": schema.Int64Attribute{
+					Optional: true,
+				},
+				"risk": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
 var opRiskCustomAttrType = map[string]attr.Type{
 	"use_and_condition":                  types.BoolType,
 	"is_eol":                             types.BoolType,
@@ -256,7 +302,7 @@ var opRiskRuleAttrTypes = map[string]attr.Type{
 	"actions":  types.SetType{ElemType: actionsSetElementType},
 }
 
-var opRiskRuleSetElementType = types.ObjectType{
+var opRiskRuleElementType = types.ObjectType{
 	AttrTypes: opRiskRuleAttrTypes,
 }
 
@@ -266,7 +312,7 @@ func (r OperationalRiskPolicyResource) fromAPIModel(ctx context.Context, policy
 
 func (r *OperationalRiskPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Version:    1,
+		Version:    2,
 		Attributes: policySchemaAttrs,
 		Blocks:     policyBlocks(opRiskPolicyCriteriaAttrs, opRiskPolicyCriteriaBlocks, commonActionsAttrs, commonActionsBlocks),
 		Description: "Creates an Xray policy using V2 of the underlying APIs. Please note: " +
@@ -274,6 +320,10 @@ func (r *OperationalRiskPolicyResource) Schema(ctx context.Context, req resource
 	}
 }
 
+func (r *OperationalRiskPolicyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return policyStateUpgraders(policyBlocksV1(opRiskPolicyCriteriaAttrsV1, opRiskPolicyCriteriaBlocksV1, commonActionsAttrs, commonActionsBlocks), opRiskRuleElementType)
+}
+
 func (r *OperationalRiskPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -298,6 +348,10 @@ func (r *OperationalRiskPolicyResource) Delete(ctx context.Context, req resource
 	r.PolicyResource.Delete(ctx, req, resp)
 }
 
+func (r *OperationalRiskPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.PolicyResource.ModifyPlan(ctx, req, resp)
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *OperationalRiskPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	r.PolicyResource.ImportState(ctx, req, resp)
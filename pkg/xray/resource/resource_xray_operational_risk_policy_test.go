@@ -96,6 +96,82 @@ func TestAccOperationalRiskPolicy_UpgradeFromSDKv2(t *testing.T) {
 	})
 }
 
+func TestAccOperationalRiskPolicy_UpgradeRulesFromSet(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_operational_risk_policy")
+
+	testData := sdk.MergeMaps(testDataOperationalRisk)
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-operational-risk-policy-%d", testutil.RandomInt())
+
+	config := util.ExecuteTemplate(fqrn, `
+	resource "xray_operational_risk_policy" "{{ .resource_name }}" {
+		name        = "{{ .policy_name }}"
+		description = "{{ .policy_description }}"
+		type        = "operational_risk"
+
+		rule {
+			name     = "rule-min-risk"
+			priority = 1
+			criteria {
+				op_risk_min_risk = "{{ .min_severity }}"
+			}
+			actions {
+				fail_build = {{ .fail_build }}
+				block_download {
+					unscanned = {{ .block_unscanned }}
+					active    = {{ .block_active }}
+				}
+			}
+		}
+
+		rule {
+			name     = "rule-custom"
+			priority = 2
+			criteria {
+				op_risk_custom {
+					use_and_condition = true
+					is_eol            = true
+					risk              = "High"
+				}
+			}
+			actions {
+				block_download {
+					active = false
+				}
+			}
+		}
+	}`, testData)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"xray": {
+						Source:            "jfrog/xray",
+						VersionConstraint: "3.0.7",
+					},
+				},
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "rule.#", "2"),
+			},
+			{
+				Config:                   config,
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rule.0.name", "rule-min-risk"),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.name", "rule-custom"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccOperationalRiskPolicy_withProjectKey(t *testing.T) {
 	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_operational_risk_policy")
 	projectKey := fmt.Sprintf("testproj%d", testutil.RandSelect(1, 2, 3, 4, 5))
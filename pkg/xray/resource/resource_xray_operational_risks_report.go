@@ -20,7 +20,8 @@ var _ resource.Resource = &OperationalRisksReportResource{}
 func NewOperationalRisksReportResource() resource.Resource {
 	return &OperationalRisksReportResource{
 		ReportResource: ReportResource{
-			TypeName: "xray_operational_risks_report",
+			TypeName:   "xray_operational_risks_report",
+			ReportType: "operationalRisks",
 		},
 	}
 }
@@ -64,6 +65,29 @@ func (r *OperationalRisksReportResource) toFiltersAPIModel(ctx context.Context,
 	return filters, diags
 }
 
+var opRisksFiltersAttrTypes = map[string]attr.Type{
+	"component": types.StringType,
+	"artifact":  types.StringType,
+	"risks":     types.SetType{ElemType: types.StringType},
+	"scan_date": types.SetType{ElemType: types.ObjectType{AttrTypes: startAndEndDateAttrTypes}},
+}
+
+func (r *OperationalRisksReportResource) fromFiltersAPIModel(ctx context.Context, filters *FiltersAPIModel, priorAttrs map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(
+		opRisksFiltersAttrTypes,
+		map[string]attr.Value{
+			"component": stringValueOrNull(filters.Component),
+			"artifact":  stringValueOrNull(filters.Artifact),
+			"risks":     stringSetValue(filters.Risks),
+			"scan_date": startAndEndDateSetValue(filters.ScanDate),
+		},
+	)
+}
+
+func (r OperationalRisksReportResource) fromAPIModel(ctx context.Context, report ReportAPIModel, state *ReportResourceModel) diag.Diagnostics {
+	return state.fromAPIModel(ctx, report, r.fromFiltersAPIModel)
+}
+
 func (r OperationalRisksReportResource) toAPIModel(ctx context.Context, plan ReportResourceModel, report *ReportAPIModel) diag.Diagnostics {
 	return plan.toAPIModel(ctx, report, r.toFiltersAPIModel)
 }
@@ -149,15 +173,19 @@ func (r *OperationalRisksReportResource) Configure(ctx context.Context, req reso
 }
 
 func (r *OperationalRisksReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	r.ReportResource.Create(ctx, "violations", r.toAPIModel, req, resp)
+	r.ReportResource.Create(ctx, r.toAPIModel, req, resp)
 }
 
 func (r *OperationalRisksReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	r.ReportResource.Read(ctx, req, resp)
+	r.ReportResource.Read(ctx, r.fromAPIModel, req, resp)
 }
 
 func (r *OperationalRisksReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	r.ReportResource.Update(ctx, "violations", r.toAPIModel, req, resp)
+	r.ReportResource.Update(ctx, req, resp)
+}
+
+func (r *OperationalRisksReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ReportResource.ImportState(ctx, req, resp)
 }
 
 func (r *OperationalRisksReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -0,0 +1,429 @@
+package xray
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/samber/lo"
+)
+
+var _ resource.Resource = &GenericPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &GenericPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &GenericPolicyResource{}
+var _ resource.ResourceWithMoveState = &GenericPolicyResource{}
+
+func NewGenericPolicyResource() resource.Resource {
+	return &GenericPolicyResource{
+		PolicyResource: PolicyResource{
+			TypeName: "xray_policy",
+		},
+	}
+}
+
+// GenericPolicyResource manages a policy of any type. The criteria and actions
+// of the rules are the union of the ones of each policy type, and the ones
+// matching the policy type are mapped by the resource of that type.
+type GenericPolicyResource struct {
+	PolicyResource
+}
+
+func (r *GenericPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+// policyTypeCriteriaAttrTypes are the criteria supported by each policy type.
+var policyTypeCriteriaAttrTypes = map[string]map[string]attr.Type{
+	"security":         securityCriteriaAttrTypes,
+	"license":          licenseCriteriaAttrTypes,
+	"operational_risk": opRiskCriteriaAttrTypes,
+}
+
+var policyCriteriaAttrTypes = lo.Assign(
+	securityCriteriaAttrTypes,
+	licenseCriteriaAttrTypes,
+	opRiskCriteriaAttrTypes,
+)
+
+var policyCriteriaSetElementType = types.ObjectType{
+	AttrTypes: policyCriteriaAttrTypes,
+}
+
+// policyCriteriaDefaults are the values of the criteria with a default, for
+// the policy types which don't support them. The other criteria are null.
+var policyCriteriaDefaults = map[string]attr.Value{
+	"allow_unknown":            types.BoolValue(true),
+	"multi_license_permissive": types.BoolValue(false),
+}
+
+// policyActionsDefaults are the values of the actions with a default, for the
+// policy types which don't support them.
+var policyActionsDefaults = map[string]attr.Value{
+	"custom_severity": types.StringValue("High"),
+}
+
+var policyRuleAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"priority": types.Int64Type,
+	"criteria": types.SetType{ElemType: policyCriteriaSetElementType},
+	"actions":  types.SetType{ElemType: licenseActionsSetElementType},
+}
+
+var policyRuleElementType = types.ObjectType{
+	AttrTypes: policyRuleAttrTypes,
+}
+
+func (r GenericPolicyResource) toAPIModel(ctx context.Context, plan PolicyResourceModel, policy *PolicyAPIModel) diag.Diagnostics {
+	switch plan.Type.ValueString() {
+	case "security":
+		return SecurityPolicyResource{}.toAPIModel(ctx, plan, policy)
+	case "license":
+		return LicensePolicyResource{}.toAPIModel(ctx, plan, policy)
+	case "operational_risk":
+		return OperationalRiskPolicyResource{}.toAPIModel(ctx, plan, policy)
+	}
+
+	diags := diag.Diagnostics{}
+	diags.AddAttributeError(
+		path.Root("type"),
+		"Invalid Attribute Value",
+		fmt.Sprintf("Policy type '%s' is not supported.", plan.Type.ValueString()),
+	)
+	return diags
+}
+
+func (r GenericPolicyResource) fromAPIModel(ctx context.Context, policy PolicyAPIModel, plan *PolicyResourceModel) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+
+	switch policy.Type {
+	case "security":
+		diags.Append(SecurityPolicyResource{}.fromAPIModel(ctx, policy, plan)...)
+	case "license":
+		diags.Append(LicensePolicyResource{}.fromAPIModel(ctx, policy, plan)...)
+	case "operational_risk":
+		diags.Append(OperationalRiskPolicyResource{}.fromAPIModel(ctx, policy, plan)...)
+	default:
+		diags.AddError(
+			"Unsupported Policy Type",
+			fmt.Sprintf("Policy type '%s' is not supported.", policy.Type),
+		)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	rules, d := widenPolicyRules(ctx, plan.Rules)
+	diags.Append(d...)
+	plan.Rules = rules
+
+	return diags
+}
+
+// nullValue returns the null value of the type.
+func nullValue(ctx context.Context, attrType attr.Type) (attr.Value, error) {
+	return attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), nil))
+}
+
+// widenObjectSet maps the objects of the set to the element type, which has
+// a superset of their attributes. The missing attributes are set to their
+// default, or null.
+func widenObjectSet(ctx context.Context, set types.Set, elementType types.ObjectType, defaults map[string]attr.Value) (types.Set, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	if set.IsNull() || set.IsUnknown() {
+		return types.SetNull(elementType), diags
+	}
+
+	elems := make([]attr.Value, 0, len(set.Elements()))
+	for _, elem := range set.Elements() {
+		attrs := elem.(types.Object).Attributes()
+
+		values := make(map[string]attr.Value, len(elementType.AttrTypes))
+		for name, attrType := range elementType.AttrTypes {
+			if value, ok := attrs[name]; ok {
+				values[name] = value
+				continue
+			}
+
+			if value, ok := defaults[name]; ok {
+				values[name] = value
+				continue
+			}
+
+			value, err := nullValue(ctx, attrType)
+			if err != nil {
+				diags.AddError("Unable to map policy rule", err.Error())
+				return set, diags
+			}
+			values[name] = value
+		}
+
+		obj, d := types.ObjectValue(elementType.AttrTypes, values)
+		if d.HasError() {
+			diags.Append(d...)
+			return set, diags
+		}
+
+		elems = append(elems, obj)
+	}
+
+	widened, d := types.SetValue(elementType, elems)
+	diags.Append(d...)
+
+	return widened, diags
+}
+
+// widenPolicyRules maps the rules of a policy of any type to the rules of the
+// generic policy.
+func widenPolicyRules(ctx context.Context, rules types.List) (types.List, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	if rules.IsNull() || rules.IsUnknown() {
+		return types.ListNull(policyRuleElementType), diags
+	}
+
+	elems := make([]attr.Value, 0, len(rules.Elements()))
+	for _, rule := range rules.Elements() {
+		attrs := rule.(types.Object).Attributes()
+
+		criteria, d := widenObjectSet(ctx, attrs["criteria"].(types.Set), policyCriteriaSetElementType, policyCriteriaDefaults)
+		diags.Append(d...)
+
+		actions, d := widenObjectSet(ctx, attrs["actions"].(types.Set), licenseActionsSetElementType, policyActionsDefaults)
+		diags.Append(d...)
+
+		if diags.HasError() {
+			return rules, diags
+		}
+
+		obj, d := types.ObjectValue(
+			policyRuleAttrTypes,
+			map[string]attr.Value{
+				"name":     attrs["name"],
+				"priority": attrs["priority"],
+				"criteria": criteria,
+				"actions":  actions,
+			},
+		)
+		if d.HasError() {
+			diags.Append(d...)
+			return rules, diags
+		}
+
+		elems = append(elems, obj)
+	}
+
+	widened, d := types.ListValue(policyRuleElementType, elems)
+	diags.Append(d...)
+
+	return widened, diags
+}
+
+var genericPolicySchemaAttrs = lo.Assign(
+	policySchemaAttrs,
+	map[string]schema.Attribute{
+		"type": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("security", "license", "operational_risk"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Description: "Type of the policy: `security`, `license` or `operational_risk`. Selects the criteria and actions supported by the rules. Changing it replaces the policy.",
+		},
+	},
+)
+
+var genericPolicyCriteriaAttrs = lo.Assign(
+	securityPolicyCriteriaAttrs,
+	licensePolicyCriteriaAttrs,
+	opRiskPolicyCriteriaAttrs,
+)
+
+var genericPolicyCriteriaBlocks = lo.Assign(
+	securityPolicyCriteriaBlocks,
+	licensePolicyCriteriaBlocks,
+	opRiskPolicyCriteriaBlocks,
+)
+
+func (r *GenericPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: genericPolicySchemaAttrs,
+		Blocks:     policyBlocks(genericPolicyCriteriaAttrs, genericPolicyCriteriaBlocks, licensePolicyActionsAttrs, commonActionsBlocks),
+		Description: "Creates an Xray policy of any type using V2 of the underlying APIs. The `type` selects the criteria and actions supported by the rules, " +
+			"the criteria and actions of the other policy types can't be set. Please note: " +
+			"It's only compatible with Bearer token auth method (Identity and Access => Access Tokens)",
+	}
+}
+
+func (r *GenericPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// isConfigured returns whether the value is set in the configuration. Nested
+// blocks which are not configured are empty lists.
+func isConfigured(value attr.Value) bool {
+	if value.IsNull() {
+		return false
+	}
+
+	if list, ok := value.(types.List); ok && !list.IsUnknown() {
+		return len(list.Elements()) > 0
+	}
+
+	return true
+}
+
+func (r GenericPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PolicyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If type or rule is not known yet, return without warning.
+	if data.Type.IsNull() || data.Type.IsUnknown() || data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return
+	}
+
+	policyType := data.Type.ValueString()
+	supportedCriteria, ok := policyTypeCriteriaAttrTypes[policyType]
+	if !ok {
+		return
+	}
+
+	for index, rule := range data.Rules.Elements() {
+		ruleAttrs := rule.(types.Object).Attributes()
+		rulePath := path.Root("rule").AtListIndex(index)
+
+		criteria := ruleAttrs["criteria"].(types.Set)
+		if !criteria.IsUnknown() {
+			for _, elem := range criteria.Elements() {
+				for name, value := range elem.(types.Object).Attributes() {
+					if _, ok := supportedCriteria[name]; ok || !isConfigured(value) {
+						continue
+					}
+
+					resp.Diagnostics.AddAttributeError(
+						rulePath.AtName("criteria").AtSetValue(elem).AtName(name),
+						"Invalid Attribute Configuration",
+						fmt.Sprintf("%s is not supported by '%s' policies.", name, policyType),
+					)
+				}
+			}
+		}
+
+		actions := ruleAttrs["actions"].(types.Set)
+		if policyType != "license" && !actions.IsUnknown() {
+			for _, elem := range actions.Elements() {
+				if isConfigured(elem.(types.Object).Attributes()["custom_severity"]) {
+					resp.Diagnostics.AddAttributeError(
+						rulePath.AtName("actions").AtSetValue(elem).AtName("custom_severity"),
+						"Invalid Attribute Configuration",
+						fmt.Sprintf("custom_severity is not supported by '%s' policies.", policyType),
+					)
+				}
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if policyType == "security" {
+		SecurityPolicyResource{}.ValidateConfig(ctx, req, resp)
+	}
+}
+
+// MoveState moves the state of the policy resources of a single type to this
+// resource, with a `moved` block.
+func (r *GenericPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	sources := []resource.Resource{
+		NewSecurityPolicyResource(),
+		NewLicensePolicyResource(),
+		NewOperationalRiskPolicyResource(),
+	}
+
+	return lo.Map(sources, func(source resource.Resource, _ int) resource.StateMover {
+		metadataResp := resource.MetadataResponse{}
+		source.Metadata(ctx, resource.MetadataRequest{}, &metadataResp)
+
+		schemaResp := resource.SchemaResponse{}
+		source.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		return resource.StateMover{
+			SourceSchema: &schemaResp.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != metadataResp.TypeName || !strings.HasSuffix(req.SourceProviderAddress, "jfrog/xray") {
+					return
+				}
+
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("The state of %s can't be read. Apply the configuration with this version of the provider before moving it.", req.SourceTypeName),
+					)
+					return
+				}
+
+				var sourceStateData PolicyResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &sourceStateData)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				rules, d := widenPolicyRules(ctx, sourceStateData.Rules)
+				resp.Diagnostics.Append(d...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				sourceStateData.Rules = rules
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, sourceStateData)...)
+			},
+		}
+	})
+}
+
+func (r *GenericPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	r.PolicyResource.Create(ctx, r.toAPIModel, r.fromAPIModel, req, resp)
+}
+
+func (r *GenericPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	r.PolicyResource.Read(ctx, r.fromAPIModel, req, resp)
+}
+
+func (r *GenericPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.PolicyResource.Update(ctx, r.toAPIModel, r.fromAPIModel, req, resp)
+}
+
+func (r *GenericPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.PolicyResource.Delete(ctx, req, resp)
+}
+
+func (r *GenericPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.PolicyResource.ModifyPlan(ctx, req, resp)
+}
+
+// ImportState imports the resource into the Terraform state.
+func (r *GenericPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.PolicyResource.ImportState(ctx, req, resp)
+}
@@ -0,0 +1,303 @@
+package xray_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+const policyTemplate = `resource "xray_policy" "{{ .resource_name }}" {
+  name        = "{{ .policy_name }}"
+  description = "policy created by xray acceptance tests"
+  type        = "{{ .policy_type }}"
+
+  rule {
+    name = "{{ .rule_name }}"
+
+    criteria {
+      {{ .criteria }}
+    }
+
+    actions {
+      fail_build = true
+      {{ .actions }}
+
+      block_download {
+        unscanned = false
+        active    = false
+      }
+    }
+  }
+}`
+
+func TestAccPolicy_security(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_policy")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-policy-%d", testutil.RandomInt()),
+		"policy_type":   "security",
+		"rule_name":     fmt.Sprintf("test-security-rule-%d", testutil.RandomInt()),
+		"criteria":      `min_severity = "High"`,
+		"actions":       "",
+	}
+	config := util.ExecuteTemplate(fqrn, policyTemplate, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["policy_name"]),
+					resource.TestCheckResourceAttr(fqrn, "type", "security"),
+					resource.TestCheckResourceAttr(fqrn, "rule.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.min_severity", "High"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.actions.0.fail_build", "true"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        testData["policy_name"],
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccPolicy_license(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_policy")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-policy-%d", testutil.RandomInt()),
+		"policy_type":   "license",
+		"rule_name":     fmt.Sprintf("test-license-rule-%d", testutil.RandomInt()),
+		"criteria":      `banned_licenses = ["0BSD", "AAL"]`,
+		"actions":       `custom_severity = "Medium"`,
+	}
+	config := util.ExecuteTemplate(fqrn, policyTemplate, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["policy_name"]),
+					resource.TestCheckResourceAttr(fqrn, "type", "license"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.banned_licenses.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.allow_unknown", "true"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.actions.0.custom_severity", "Medium"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccPolicy_operationalRisk(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_policy")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-policy-%d", testutil.RandomInt()),
+		"policy_type":   "operational_risk",
+		"rule_name":     fmt.Sprintf("test-operational-risk-rule-%d", testutil.RandomInt()),
+		"criteria":      `op_risk_min_risk = "Medium"`,
+		"actions":       "",
+	}
+	config := util.ExecuteTemplate(fqrn, policyTemplate, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["policy_name"]),
+					resource.TestCheckResourceAttr(fqrn, "type", "operational_risk"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.op_risk_min_risk", "Medium"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccPolicy_criteriaOfAnotherType(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_policy")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-policy-%d", testutil.RandomInt()),
+		"policy_type":   "security",
+		"rule_name":     fmt.Sprintf("test-security-rule-%d", testutil.RandomInt()),
+		"criteria":      `banned_licenses = ["0BSD"]`,
+		"actions":       "",
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      util.ExecuteTemplate(fqrn, policyTemplate, testData),
+				ExpectError: regexp.MustCompile(`.*banned_licenses is not supported by 'security' policies.*`),
+			},
+		},
+	})
+}
+
+func TestAccPolicy_actionsOfAnotherType(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_policy")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-policy-%d", testutil.RandomInt()),
+		"policy_type":   "operational_risk",
+		"rule_name":     fmt.Sprintf("test-operational-risk-rule-%d", testutil.RandomInt()),
+		"criteria":      `op_risk_min_risk = "Medium"`,
+		"actions":       `custom_severity = "Medium"`,
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      util.ExecuteTemplate(fqrn, policyTemplate, testData),
+				ExpectError: regexp.MustCompile(`.*custom_severity is not supported by 'operational_risk' policies.*`),
+			},
+		},
+	})
+}
+
+func TestAccPolicy_moveFromSecurityPolicy(t *testing.T) {
+	_, sourceFqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+	fqrn := "xray_policy." + resourceName
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-policy-%d", testutil.RandomInt()),
+		"rule_name":     fmt.Sprintf("test-security-rule-%d", testutil.RandomInt()),
+	}
+
+	sourceConfig := util.ExecuteTemplate(sourceFqrn, `
+		resource "xray_security_policy" "{{ .resource_name }}" {
+		  name        = "{{ .policy_name }}"
+		  description = "policy created by xray acceptance tests"
+		  type        = "security"
+
+		  rule {
+		    name = "{{ .rule_name }}"
+
+		    criteria {
+		      min_severity = "High"
+		    }
+
+		    actions {
+		      fail_build = true
+
+		      block_download {
+		        unscanned = false
+		        active    = false
+		      }
+		    }
+		  }
+		}
+	`, testData)
+
+	movedConfig := util.ExecuteTemplate(fqrn, `
+		moved {
+		  from = xray_security_policy.{{ .resource_name }}
+		  to   = xray_policy.{{ .resource_name }}
+		}
+
+		resource "xray_policy" "{{ .resource_name }}" {
+		  name        = "{{ .policy_name }}"
+		  description = "policy created by xray acceptance tests"
+		  type        = "security"
+
+		  rule {
+		    name = "{{ .rule_name }}"
+
+		    criteria {
+		      min_severity = "High"
+		    }
+
+		    actions {
+		      fail_build = true
+
+		      block_download {
+		        unscanned = false
+		        active    = false
+		      }
+		    }
+		  }
+		}
+	`, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.PreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: sourceConfig,
+				Check:  resource.TestCheckResourceAttr(sourceFqrn, "name", testData["policy_name"]),
+			},
+			{
+				Config: movedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "name", testData["policy_name"]),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.min_severity", "High"),
+				),
+			},
+		},
+	})
+}
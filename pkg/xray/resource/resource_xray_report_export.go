@@ -0,0 +1,294 @@
+package xray
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+const ReportExportEndpoint = "xray/api/v1/reports/export/{reportId}"
+
+var _ resource.Resource = &ReportExportResource{}
+
+func NewReportExportResource() resource.Resource {
+	return &ReportExportResource{
+		TypeName: "xray_report_export",
+	}
+}
+
+type ReportExportResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+type ReportExportResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ReportID   types.String `tfsdk:"report_id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	Format     types.String `tfsdk:"format"`
+	FileName   types.String `tfsdk:"file_name"`
+	OutputPath types.String `tfsdk:"output_path"`
+	SHA256     types.String `tfsdk:"sha256"`
+}
+
+func (r *ReportExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+func (r *ReportExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: lo.Assign(
+			projectKeySchemaAttrs(true, ""),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"report_id": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Description: "ID of the report to export, e.g. the `id` attribute of a `xray_violations_report` resource. The report must be completed.",
+				},
+				"format": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("json", "csv", "pdf"),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Description: "Format of the exported report. Allowed values: `json`, `csv` or `pdf`.",
+				},
+				"file_name": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					Default:  stringdefault.StaticString("report"),
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Description: "Name of the exported file, without extension, as sent to Xray. Default value is `report`.",
+				},
+				"output_path": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Description: "Local path of the file the unzipped report is written to. Missing parent directories are created.",
+				},
+				"sha256": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "SHA-256 checksum of the exported file, hex encoded.",
+				},
+			},
+		),
+		MarkdownDescription: "Exports a generated Xray report and writes it to a local file. The export is unzipped, so `output_path` holds the `json`, `csv` or `pdf` file directly. " +
+			"The file is written again if it is removed or modified outside of Terraform. See JFrog [Export Report API documentation](https://jfrog.com/help/r/xray-rest-apis/export) for more details.",
+	}
+}
+
+func (r *ReportExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// unzipReport returns the content of the single file of the zip archive
+// returned by the export endpoint.
+func unzipReport(data []byte) ([]byte, error) {
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	files := lo.Filter(reader.File, func(file *zip.File, _ int) bool {
+		return !file.FileInfo().IsDir()
+	})
+	if len(files) != 1 {
+		return nil, fmt.Errorf("expected exactly one file in exported report archive, found %d", len(files))
+	}
+
+	file, err := files[0].Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+func fileSHA256(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	checksum := sha256.Sum256(content)
+	return hex.EncodeToString(checksum[:]), nil
+}
+
+func (r *ReportExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ReportExportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, err := getRestyRequest(r.ProviderData.Client, plan.ProjectKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"failed to get Resty client",
+			err.Error(),
+		)
+		return
+	}
+
+	response, err := request.
+		SetPathParam("reportId", plan.ReportID.ValueString()).
+		SetQueryParams(map[string]string{
+			"file_name": plan.FileName.ValueString(),
+			"format":    plan.Format.ValueString(),
+		}).
+		Get(ReportExportEndpoint)
+
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if response.IsError() {
+		utilfw.UnableToCreateResourceError(resp, response.String())
+		return
+	}
+
+	content, err := unzipReport(response.Body())
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, fmt.Sprintf("failed to unzip exported report: %s", err))
+		return
+	}
+
+	outputPath := plan.OutputPath.ValueString()
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	if err := os.WriteFile(outputPath, content, 0644); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	checksum := sha256.Sum256(content)
+	plan.ID = plan.ReportID
+	plan.SHA256 = types.StringValue(hex.EncodeToString(checksum[:]))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReportExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ReportExportResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The exported file only lives locally, so a missing or modified file
+	// means the report needs to be exported again.
+	checksum, err := fileSHA256(state.OutputPath.ValueString())
+	if errors.Is(err, os.ErrNotExist) || (err == nil && checksum != state.SHA256.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ReportExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan ReportExportResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All configurable attributes require replacement, so there is nothing
+	// to send to Xray.
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ReportExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state ReportExportResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := os.Remove(state.OutputPath.ValueString())
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
@@ -0,0 +1,89 @@
+package xray_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+func TestAccReportExport_Licenses(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("report-export-", "xray_report_export")
+	outputPath := filepath.Join(t.TempDir(), "reports", "licenses.json")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"report_name":   fmt.Sprintf("terraform-licenses-report-%d", testutil.RandomInt()),
+		"output_path":   outputPath,
+	}
+
+	const template = `
+	resource "xray_licenses_report" "{{ .resource_name }}" {
+		name = "{{ .report_name }}"
+
+		resources {
+			repository {
+				name = "repository-name"
+			}
+		}
+
+		filters {
+			unrecognized = true
+		}
+	}
+
+	resource "xray_report_export" "{{ .resource_name }}" {
+		report_id   = xray_licenses_report.{{ .resource_name }}.id
+		format      = "json"
+		file_name   = "licenses"
+		output_path = "{{ .output_path }}"
+	}
+	`
+
+	config := util.ExecuteTemplate(fqrn, template, testData)
+
+	checkFileExists := func(*terraform.State) error {
+		_, err := os.Stat(outputPath)
+		return err
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if _, err := os.Stat(outputPath); !os.IsNotExist(err) {
+				return fmt.Errorf("exported report %s still exists", outputPath)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "format", "json"),
+					resource.TestCheckResourceAttr(fqrn, "file_name", "licenses"),
+					resource.TestCheckResourceAttr(fqrn, "output_path", outputPath),
+					resource.TestCheckResourceAttrPair(fqrn, "report_id", "xray_licenses_report."+resourceName, "id"),
+					resource.TestCheckResourceAttrSet(fqrn, "sha256"),
+					checkFileExists,
+				),
+			},
+			{
+				PreConfig: func() {
+					os.Remove(outputPath)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "sha256"),
+					checkFileExists,
+				),
+			},
+		},
+	})
+}
@@ -1,17 +1,27 @@
 package xray_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
 	"github.com/go-resty/resty/v2"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+	xray "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
 )
 
 var licenseFilterFields = map[string]interface{}{
@@ -40,6 +50,18 @@ var opRisksFilterFields = map[string]interface{}{
 	},
 }
 
+var exposuresFilterFields = map[string]interface{}{
+	"filters": map[string]interface{}{
+		"category":          "secrets",
+		"impacted_artifact": "impacted-artifact",
+		"severities":        []interface{}{"High", "Critical"},
+		"scan_date": map[string]interface{}{
+			"start": "2020-06-29T12:22:16Z",
+			"end":   "2020-07-29T12:22:16Z",
+		},
+	},
+}
+
 var violationsFilterFields = []map[string]interface{}{
 	{
 		"filters": map[string]interface{}{
@@ -272,6 +294,19 @@ var resourcesListNegative = []map[string]interface{}{
 	},
 }
 
+func TestAccReport_Exposures(t *testing.T) {
+	terraformReportName := "terraform-exposures-report"
+	terraformResourceName := "xray_exposures_report"
+
+	for _, reportResource := range resourcesList {
+		resourceNameInReport := reportResource["name"].(string)
+		t.Run(resourceNameInReport, func(t *testing.T) {
+			resource.Test(mkFilterTestCase(t, reportResource, exposuresFilterFields, terraformReportName,
+				terraformResourceName))
+		})
+	}
+}
+
 func TestAccReport_Licenses(t *testing.T) {
 	terraformReportName := "terraform-licenses-report"
 	terraformResourceName := "xray_licenses_report"
@@ -388,6 +423,138 @@ func TestAccReport_Vulnerabilities_UpgradeFromSDKv2(t *testing.T) {
 	}
 }
 
+func TestAccReport_Licenses_ReplaceOnFilterChange(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("licenses-report-", "xray_licenses_report")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"report_name":   fmt.Sprintf("terraform-licenses-report-%d", testutil.RandomInt()),
+		"unrecognized":  "true",
+	}
+
+	const template = `
+	resource "xray_licenses_report" "{{ .resource_name }}" {
+		name = "{{ .report_name }}"
+
+		resources {
+			repository {
+				name = "repository-name"
+			}
+		}
+
+		filters {
+			unrecognized = {{ .unrecognized }}
+		}
+	}
+	`
+
+	updatedTestData := sdk.MergeMaps(testData)
+	updatedTestData["unrecognized"] = "false"
+
+	var previousReportID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", testCheckReport),
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(fqrn, "report_id", fqrn, "id"),
+					func(s *terraform.State) error {
+						previousReportID = s.RootModule().Resources[fqrn].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: util.ExecuteTemplate(fqrn, template, updatedTestData),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "filters.0.unrecognized", "false"),
+					resource.TestCheckResourceAttrPair(fqrn, "report_id", fqrn, "id"),
+					func(s *terraform.State) error {
+						response, err := testCheckReport(previousReportID, acctest.GetTestResty(t).R())
+						if err != nil {
+							return err
+						}
+						if response.StatusCode() != http.StatusNotFound {
+							return fmt.Errorf("superseded report %s still exists", previousReportID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccReport_ImportWrongType(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("licenses-report-", "xray_licenses_report")
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"report_name":   fmt.Sprintf("terraform-licenses-report-%d", testutil.RandomInt()),
+	}
+
+	const template = `
+	resource "xray_licenses_report" "{{ .resource_name }}" {
+		name = "{{ .report_name }}"
+
+		resources {
+			repository {
+				name = "repository-name"
+			}
+		}
+
+		filters {
+			unrecognized = true
+		}
+	}
+	`
+
+	const importTemplate = template + `
+	resource "xray_violations_report" "{{ .resource_name }}" {
+		name = "{{ .report_name }}"
+
+		resources {
+			repository {
+				name = "repository-name"
+			}
+		}
+
+		filters {
+			type = "security"
+		}
+	}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", testCheckReport),
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(fqrn, template, testData),
+			},
+			{
+				Config:       util.ExecuteTemplate(fqrn, importTemplate, testData),
+				ResourceName: "xray_violations_report." + resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources[fqrn].Primary.ID, nil
+				},
+				ExpectError: regexp.MustCompile(".*invalid report type.*"),
+			},
+		},
+	})
+}
+
 func TestAccReport_BadResource(t *testing.T) {
 	terraformReportName := "terraform-licenses-report"
 	terraformResourceName := "xray_licenses_report"
@@ -429,6 +596,22 @@ func TestAccReport_BadLicenseFilter(t *testing.T) {
 
 }
 
+func TestAccReport_BadExposuresFilter(t *testing.T) {
+	terraformReportName := "terraform-exposures-report"
+	terraformResourceName := "xray_exposures_report"
+
+	filterFields := map[string]interface{}{
+		"filters": map[string]interface{}{
+			"category": "malware",
+		},
+	}
+
+	expectedErrorMessage := "(?s).*Invalid Attribute Value Match.*category value must be one of.*"
+
+	resource.Test(mkFilterNegativeTestCase(t, resourcesList[0], filterFields, terraformReportName,
+		terraformResourceName, expectedErrorMessage))
+}
+
 func TestAccReport_BadViolationsFilter(t *testing.T) {
 	terraformReportName := "terraform-violations-report"
 	terraformResourceName := "xray_violations_report"
@@ -522,6 +705,13 @@ func mkFilterTestCase(t *testing.T, resourceFields map[string]interface{}, filte
 	defaultChecks := testutil.MapToTestChecks(fqrn, allFields)
 
 	checks := append(defaultChecks, extraChecks...)
+	checks = append(checks,
+		resource.TestCheckResourceAttr(fqrn, "timeout_in_seconds", "600"),
+		resource.TestCheckResourceAttr(fqrn, "status", "completed"),
+		resource.TestCheckResourceAttr(fqrn, "progress", "100"),
+		resource.TestCheckResourceAttrSet(fqrn, "number_of_rows"),
+		resource.TestCheckResourceAttrSet(fqrn, "end_time"),
+	)
 	config := fmt.Sprintf(remoteRepoFull, resourceName, name, allFieldsHcl)
 
 	return t, resource.TestCase{
@@ -532,6 +722,19 @@ func mkFilterTestCase(t *testing.T, resourceFields map[string]interface{}, filte
 				Config: config,
 				Check:  resource.ComposeTestCheckFunc(checks...),
 			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      fqrn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	}
 }
@@ -611,3 +814,55 @@ func checkReport(id string, request *resty.Request) (*resty.Response, error) {
 func testCheckReport(id string, request *resty.Request) (*resty.Response, error) {
 	return checkReport(id, request.AddRetryCondition(client.NeverRetry))
 }
+
+func TestReport_timeoutChangeOnFailedReport(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet && r.URL.Path == "/xray/api/v1/reports/1" {
+			json.NewEncoder(w).Encode(xray.ReportStatusAPIModel{
+				ID:     1,
+				Name:   "report",
+				Status: xray.ReportStatusFailed,
+				Error:  "fake error",
+			})
+		}
+	}))
+	defer server.Close()
+
+	r := xray.NewLicensesReportResource()
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+		ProviderData: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+	}, &fwresource.ConfigureResponse{})
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	value := func(document string) tftypes.Value {
+		v, err := tftypes.ValueFromJSON([]byte(document), schemaResp.Schema.Type().TerraformType(ctx))
+		if err != nil {
+			t.Fatalf("failed to build the report value: %s", err)
+		}
+		return v
+	}
+
+	req := fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: value(`{"id": "1", "report_id": 1, "name": "report", "timeout_in_seconds": 60}`)},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: value(`{"id": "1", "report_id": 1, "name": "report", "timeout_in_seconds": 300, "status": "failed", "error": "fake error"}`)},
+	}
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var state xray.ReportResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if state.TimeoutInSeconds.ValueInt64() != 60 || state.Status.ValueString() != xray.ReportStatusFailed || state.Error.ValueString() != "fake error" {
+		t.Errorf("expected the failed report with the new timeout, got timeout %s, status %s and error %s", state.TimeoutInSeconds, state.Status, state.Error)
+	}
+}
@@ -1,8 +1,13 @@
 package xray
 
 import (
+	"cmp"
 	"context"
+	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -22,6 +27,8 @@ import (
 )
 
 var _ resource.Resource = &SecurityPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &SecurityPolicyResource{}
+var _ resource.ResourceWithUpgradeState = &SecurityPolicyResource{}
 
 func NewSecurityPolicyResource() resource.Resource {
 	return &SecurityPolicyResource{
@@ -83,6 +90,23 @@ func (r *SecurityPolicyResource) toCriteriaAPIModel(ctx context.Context, criteri
 			diags.Append(d...)
 		}
 
+		var packages []PolicyPackageAPIModel
+		for _, elem := range attrs["packages"].(types.List).Elements() {
+			attrs := elem.(types.Object).Attributes()
+
+			var versions []string
+			d = attrs["versions"].(types.Set).ElementsAs(ctx, &versions, false)
+			if d.HasError() {
+				diags.Append(d...)
+			}
+
+			packages = append(packages, PolicyPackageAPIModel{
+				Type:     attrs["type"].(types.String).ValueString(),
+				Name:     attrs["name"].(types.String).ValueString(),
+				Versions: versions,
+			})
+		}
+
 		criteria = &PolicyRuleCriteriaAPIModel{
 			MinimumSeverity:     attrs["min_severity"].(types.String).ValueString(),
 			CVSSRange:           cvssRange,
@@ -94,6 +118,7 @@ func (r *SecurityPolicyResource) toCriteriaAPIModel(ctx context.Context, criteri
 			PackageName:         attrs["package_name"].(types.String).ValueString(),
 			PackageType:         attrs["package_type"].(types.String).ValueString(),
 			PackageVersions:     packageVersions,
+			Packages:            packages,
 		}
 	}
 
@@ -101,7 +126,181 @@ func (r *SecurityPolicyResource) toCriteriaAPIModel(ctx context.Context, criteri
 }
 
 func (r SecurityPolicyResource) toAPIModel(ctx context.Context, plan PolicyResourceModel, policy *PolicyAPIModel) diag.Diagnostics {
-	return plan.toAPIModel(ctx, policy, r.toCriteriaAPIModel, toActionsAPIModel)
+	diags := plan.toAPIModel(ctx, policy, r.toCriteriaAPIModel, toActionsAPIModel)
+
+	if policy.Rules != nil {
+		rules := expandPackageRules(*policy.Rules)
+		policy.Rules = &rules
+	}
+
+	return diags
+}
+
+// packageRuleName returns the name of the rule created for the package at the
+// index of the `packages` of the rule.
+func packageRuleName(ruleName string, index int) string {
+	return fmt.Sprintf("%s-%d", ruleName, index+1)
+}
+
+func hasPackages(rule PolicyRuleAPIModel) bool {
+	return rule.Criteria != nil && len(rule.Criteria.Packages) > 0
+}
+
+// expandPackageRules replaces each rule with `packages` by one rule per
+// package, as Xray supports a single package per rule. Rule priorities must be
+// unique, so all the rules are renumbered in order of priority.
+func expandPackageRules(rules []PolicyRuleAPIModel) []PolicyRuleAPIModel {
+	if !slices.ContainsFunc(rules, hasPackages) {
+		return rules
+	}
+
+	ordered := slices.Clone(rules)
+	slices.SortStableFunc(ordered, func(a, b PolicyRuleAPIModel) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	expanded := make([]PolicyRuleAPIModel, 0, len(ordered))
+	for _, rule := range ordered {
+		if !hasPackages(rule) {
+			rule.Priority = int64(len(expanded) + 1)
+			expanded = append(expanded, rule)
+			continue
+		}
+
+		for index, pkg := range rule.Criteria.Packages {
+			criteria := *rule.Criteria
+			criteria.Packages = nil
+			criteria.PackageName = pkg.Name
+			criteria.PackageType = pkg.Type
+			criteria.PackageVersions = pkg.Versions
+
+			expanded = append(expanded, PolicyRuleAPIModel{
+				Name:     packageRuleName(rule.Name, index),
+				Priority: int64(len(expanded) + 1),
+				Criteria: &criteria,
+				Actions:  rule.Actions,
+			})
+		}
+	}
+
+	return expanded
+}
+
+// packageRules returns the names of the rules of the model with `packages`,
+// and the priorities of all the rules by name.
+func (m PolicyResourceModel) packageRules() (map[string]bool, map[string]int64) {
+	names := map[string]bool{}
+	priorities := map[string]int64{}
+
+	if m.Rules.IsNull() || m.Rules.IsUnknown() {
+		return names, priorities
+	}
+
+	for _, elem := range m.Rules.Elements() {
+		attrs := elem.(types.Object).Attributes()
+
+		name, ok := attrs["name"].(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+
+		if priority, ok := attrs["priority"].(types.Int64); ok && !priority.IsNull() && !priority.IsUnknown() {
+			priorities[name.ValueString()] = priority.ValueInt64()
+		}
+
+		criteria, ok := attrs["criteria"].(types.Set)
+		if !ok || len(criteria.Elements()) == 0 {
+			continue
+		}
+
+		if packages, ok := criteria.Elements()[0].(types.Object).Attributes()["packages"].(types.List); ok && len(packages.Elements()) > 0 {
+			names[name.ValueString()] = true
+		}
+	}
+
+	return names, priorities
+}
+
+// packageRuleBaseName returns the name without the suffix added by
+// packageRuleName, if the name has such a suffix.
+func packageRuleBaseName(name string) (string, bool) {
+	index := strings.LastIndex(name, "-")
+	if index < 0 {
+		return "", false
+	}
+
+	if _, err := strconv.Atoi(name[index+1:]); err != nil {
+		return "", false
+	}
+
+	return name[:index], true
+}
+
+// foldedRuleName returns the name of the rule with `packages` the rule was
+// expanded from, if any.
+func foldedRuleName(rule PolicyRuleAPIModel, packageRuleNames map[string]bool) (string, bool) {
+	if rule.Criteria == nil || rule.Criteria.PackageName == "" {
+		return "", false
+	}
+
+	name, ok := packageRuleBaseName(rule.Name)
+	return name, ok && packageRuleNames[name]
+}
+
+// foldPackageRules reverts expandPackageRules for the rules configured with
+// `packages` in the model, and restores the priorities of the model. Without
+// such rules, e.g. on import, the rules are returned as they are.
+func (m PolicyResourceModel) foldPackageRules(rules []PolicyRuleAPIModel) []PolicyRuleAPIModel {
+	packageRuleNames, priorities := m.packageRules()
+	if len(packageRuleNames) == 0 {
+		return rules
+	}
+
+	ordered := slices.Clone(rules)
+	slices.SortStableFunc(ordered, func(a, b PolicyRuleAPIModel) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+
+	folded := make([]PolicyRuleAPIModel, 0, len(ordered))
+	positions := map[string]int{}
+	for _, rule := range ordered {
+		name, ok := foldedRuleName(rule, packageRuleNames)
+		if !ok {
+			folded = append(folded, rule)
+			continue
+		}
+
+		pkg := PolicyPackageAPIModel{
+			Type:     rule.Criteria.PackageType,
+			Name:     rule.Criteria.PackageName,
+			Versions: rule.Criteria.PackageVersions,
+		}
+
+		if position, found := positions[name]; found {
+			folded[position].Criteria.Packages = append(folded[position].Criteria.Packages, pkg)
+			continue
+		}
+
+		criteria := *rule.Criteria
+		criteria.PackageName = ""
+		criteria.PackageType = ""
+		criteria.PackageVersions = nil
+		criteria.Packages = []PolicyPackageAPIModel{pkg}
+
+		rule.Name = name
+		rule.Criteria = &criteria
+
+		positions[name] = len(folded)
+		folded = append(folded, rule)
+	}
+
+	for index, rule := range folded {
+		if priority, ok := priorities[rule.Name]; ok {
+			folded[index].Priority = priority
+		}
+	}
+
+	return folded
 }
 
 func (r *SecurityPolicyResource) fromCriteriaAPIModel(ctx context.Context, criteriaAPIModel *PolicyRuleCriteriaAPIModel) (types.Set, diag.Diagnostics) {
@@ -191,6 +390,45 @@ func (r *SecurityPolicyResource) fromCriteriaAPIModel(ctx context.Context, crite
 			diags.Append(d...)
 		}
 
+		packagesList := types.ListNull(packageElementType)
+		if len(criteriaAPIModel.Packages) > 0 {
+			packages := lo.Map(
+				criteriaAPIModel.Packages,
+				func(pkg PolicyPackageAPIModel, _ int) attr.Value {
+					versions := types.SetNull(types.StringType)
+					if len(pkg.Versions) > 0 {
+						vs, d := types.SetValueFrom(ctx, types.StringType, pkg.Versions)
+						if d.HasError() {
+							diags.Append(d...)
+						}
+
+						versions = vs
+					}
+
+					p, d := types.ObjectValue(
+						packageAttrTypes,
+						map[string]attr.Value{
+							"type":     types.StringValue(pkg.Type),
+							"name":     types.StringValue(pkg.Name),
+							"versions": versions,
+						},
+					)
+					if d.HasError() {
+						diags.Append(d...)
+					}
+
+					return p
+				},
+			)
+
+			ps, d := types.ListValue(packageElementType, packages)
+			if d.HasError() {
+				diags.Append(d...)
+			}
+
+			packagesList = ps
+		}
+
 		criteria, d := types.ObjectValue(
 			securityCriteriaAttrTypes,
 			map[string]attr.Value{
@@ -204,6 +442,7 @@ func (r *SecurityPolicyResource) fromCriteriaAPIModel(ctx context.Context, crite
 				"package_name":          packageName,
 				"package_type":          packageType,
 				"package_versions":      packageVersions,
+				"packages":              packagesList,
 			},
 		)
 		if d.HasError() {
@@ -224,6 +463,11 @@ func (r *SecurityPolicyResource) fromCriteriaAPIModel(ctx context.Context, crite
 }
 
 func (r SecurityPolicyResource) fromAPIModel(ctx context.Context, policy PolicyAPIModel, plan *PolicyResourceModel) diag.Diagnostics {
+	if policy.Rules != nil {
+		rules := plan.foldPackageRules(*policy.Rules)
+		policy.Rules = &rules
+	}
+
 	return plan.fromAPIModel(ctx, policy, r.fromCriteriaAPIModel, fromActionsAPIModel)
 }
 
@@ -248,6 +492,16 @@ var exposuresElementType = types.ObjectType{
 	AttrTypes: exposuresAttrType,
 }
 
+var packageAttrTypes = map[string]attr.Type{
+	"type":     types.StringType,
+	"name":     types.StringType,
+	"versions": types.SetType{ElemType: types.StringType},
+}
+
+var packageElementType = types.ObjectType{
+	AttrTypes: packageAttrTypes,
+}
+
 var securityCriteriaAttrTypes = map[string]attr.Type{
 	"min_severity":          types.StringType,
 	"fix_version_dependant": types.BoolType,
@@ -259,6 +513,7 @@ var securityCriteriaAttrTypes = map[string]attr.Type{
 	"package_name":          types.StringType,
 	"package_type":          types.StringType,
 	"package_versions":      types.SetType{ElemType: types.StringType},
+	"packages":              types.ListType{ElemType: packageElementType},
 }
 
 var securityCriteriaSetElementType = types.ObjectType{
@@ -281,10 +536,14 @@ var securityRuleAttrTypes = map[string]attr.Type{
 	"actions":  types.SetType{ElemType: actionsSetElementType},
 }
 
-var securityRuleSetElementType = types.ObjectType{
+var securityRuleElementType = types.ObjectType{
 	AttrTypes: securityRuleAttrTypes,
 }
 
+var packageVersionsValidator = setvalidator.ValueStringsAre(
+	stringvalidator.RegexMatches(regexp.MustCompile(`((^(\(|\[)((\d+\.)?(\d+\.)?(\*|\d+)|(\s*))\,((\d+\.)?(\d+\.)?(\*|\d+)|(\s*))(\)|\])$|^\[(\d+\.)?(\d+\.)?(\*|\d+)\]$))`), "invalid Range, must be one of the follows: Any Version: (,) or Specific Version: [1.2], [3] or Range: (1,), [,1.2.3], (4.5.0,6.5.2]"),
+)
+
 var securityPolicyCriteriaBlocks = map[string]schema.Block{
 	"cvss_range": schema.ListNestedBlock{
 		NestedObject: schema.NestedBlockObject{
@@ -365,6 +624,43 @@ var securityPolicyCriteriaBlocks = map[string]schema.Block{
 		},
 		Description: "Creates policy rules for specific exposures.\n\n~>Only supported by JFrog Advanced Security",
 	},
+	"packages": schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(validPackageTypesSupportedXraySecPolicies...),
+					},
+					Description: "The package type.",
+				},
+				"name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					Description: "The package name.",
+				},
+				"versions": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.Set{
+						packageVersionsValidator,
+					},
+					Description: "package versions to apply the rule on can be (,) for any version or an open range (1,4) or closed [1,4] or one version [1]",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.ConflictsWith(
+				path.MatchRelative().AtParent().AtName("package_name"),
+				path.MatchRelative().AtParent().AtName("package_type"),
+				path.MatchRelative().AtParent().AtName("package_versions"),
+				path.MatchRelative().AtParent().AtName("vulnerability_ids"),
+			),
+		},
+		Description: "Creates a policy rule for each package, with the other criteria and the actions of the rule. The rules are named after the rule with the position of the package as suffix, e.g. `rule-name-1`, and take consecutive priorities, which renumbers the priorities of all the rules of the policy in Xray. Other rules can't be named like the rules of the packages. Imported policies keep the rule of each package, with the renumbered priorities, instead of the rule with `packages`.",
+	},
 }
 
 var securityPolicyCriteriaAttrs = map[string]schema.Attribute{
@@ -441,17 +737,88 @@ var securityPolicyCriteriaAttrs = map[string]schema.Attribute{
 		ElementType: types.StringType,
 		Optional:    true,
 		Validators: []validator.Set{
-			setvalidator.ValueStringsAre(
-				stringvalidator.RegexMatches(regexp.MustCompile(`((^(\(|\[)((\d+\.)?(\d+\.)?(\*|\d+)|(\s*))\,((\d+\.)?(\d+\.)?(\*|\d+)|(\s*))(\)|\])$|^\[(\d+\.)?(\d+\.)?(\*|\d+)\]$))`), "invalid Range, must be one of the follows: Any Version: (,) or Specific Version: [1.2], [3] or Range: (1,), [,1.2.3], (4.5.0,6.5.2]"),
-			),
+			packageVersionsValidator,
 		},
 		Description: "package versions to apply the rule on can be (,) for any version or an open range (1,4) or closed [1,4] or one version [1]",
 	},
 }
 
+// securityPolicyCriteriaAttrsV1 and securityPolicyCriteriaBlocksV1 are the
+// criteria of the schema version 1, which must not follow the changes of the
+// current criteria to read the prior state.
+var securityPolicyCriteriaAttrsV1 = map[string]schema.Attribute{
+	"min_severity": schema.StringAttribute{
+		Optional: true,
+	},
+	"fix_version_dependant": schema.BoolAttribute{
+		Optional: true,
+	},
+	"applicable_cves_only": schema.BoolAttribute{
+		Optional: true,
+	},
+	"malicious_package": schema.BoolAttribute{
+		Optional: true,
+	},
+	"vulnerability_ids": schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	},
+	"package_name": schema.StringAttribute{
+		Optional: true,
+	},
+	"package_type": schema.StringAttribute{
+		Optional: true,
+	},
+	"package_versions": schema.SetAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+	},
+}
+
+var securityPolicyCriteriaBlocksV1 = map[string]schema.Block{
+	"cvss_range": schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"from": schema.Float64Attribute{
+					Required: true,
+				},
+				"to": schema.Float64Attribute{
+					Required: true,
+				},
+			},
+		},
+	},
+	"exposures": schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"min_severity": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"secrets": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"applications": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"services": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+				"iac": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
 func (r *SecurityPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Version:    1,
+		Version:    2,
 		Attributes: policySchemaAttrs,
 		Blocks:     policyBlocks(securityPolicyCriteriaAttrs, securityPolicyCriteriaBlocks, commonActionsAttrs, commonActionsBlocks),
 		Description: "Creates an Xray policy using V2 of the underlying APIs. Please note: " +
@@ -459,6 +826,10 @@ func (r *SecurityPolicyResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+func (r *SecurityPolicyResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return policyStateUpgraders(policyBlocksV1(securityPolicyCriteriaAttrsV1, securityPolicyCriteriaBlocksV1, commonActionsAttrs, commonActionsBlocks), securityRuleElementType)
+}
+
 func (r *SecurityPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -480,8 +851,23 @@ func (r SecurityPolicyResource) ValidateConfig(ctx context.Context, req resource
 		return
 	}
 
-	for _, rule := range data.Rules.Elements() {
+	// The rules expanded from `packages` are read back by name, so other rules
+	// must not be named like them.
+	packageRuleNames, _ := data.packageRules()
+
+	for index, rule := range data.Rules.Elements() {
 		ruleAttrs := rule.(types.Object).Attributes()
+
+		name := ruleAttrs["name"].(types.String).ValueString()
+		if base, ok := packageRuleBaseName(name); ok && packageRuleNames[base] && !packageRuleNames[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rule").AtListIndex(index).AtName("name"),
+				"Invalid Attribute Configuration",
+				fmt.Sprintf("rule name '%s' is reserved for the rules created for the packages of rule '%s'", name, base),
+			)
+			return
+		}
+
 		criteria := ruleAttrs["criteria"].(types.Set)
 		attrs := criteria.Elements()[0].(types.Object).Attributes()
 
@@ -492,7 +878,7 @@ func (r SecurityPolicyResource) ValidateConfig(ctx context.Context, req resource
 
 		if maliciousPackage && minSeverity != "" {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("rules").AtSetValue(rule).AtName("criteria").AtSetValue(criteria.Elements()[0]).AtName("malicious_package"),
+				path.Root("rule").AtListIndex(index).AtName("criteria").AtSetValue(criteria.Elements()[0]).AtName("malicious_package"),
 				"Invalid Attribute Configuration",
 				"malicious_package cannot be set to 'true' if min_severity is set",
 			)
@@ -501,7 +887,7 @@ func (r SecurityPolicyResource) ValidateConfig(ctx context.Context, req resource
 
 		if maliciousPackage && !cvssRange.IsNull() {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("rules").AtSetValue(rule).AtName("criteria").AtSetValue(criteria.Elements()[0]).AtName("malicious_package"),
+				path.Root("rule").AtListIndex(index).AtName("criteria").AtSetValue(criteria.Elements()[0]).AtName("malicious_package"),
 				"Invalid Attribute Configuration",
 				"malicious_package cannot be set to 'true' if cvss_range is set",
 			)
@@ -510,7 +896,7 @@ func (r SecurityPolicyResource) ValidateConfig(ctx context.Context, req resource
 
 		if maliciousPackage && fixVersionDependant {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("rules").AtSetValue(rule).AtName("criteria").AtSetValue(criteria.Elements()[0]).AtName("fix_version_dependant"),
+				path.Root("rule").AtListIndex(index).AtName("criteria").AtSetValue(criteria.Elements()[0]).AtName("fix_version_dependant"),
 				"Invalid Attribute Configuration",
 				"fix_version_dependant must be set to 'false' if malicious_package is 'true'",
 			)
@@ -520,10 +906,11 @@ func (r SecurityPolicyResource) ValidateConfig(ctx context.Context, req resource
 		packageName := attrs["package_name"].(types.String)
 		packagType := attrs["package_type"].(types.String)
 		packageVersions := attrs["package_versions"].(types.Set)
+		packages := attrs["packages"].(types.List)
 
-		if fixVersionDependant && (!packageName.IsNull() || !packagType.IsNull() || !packageVersions.IsNull()) {
+		if fixVersionDependant && (!packageName.IsNull() || !packagType.IsNull() || !packageVersions.IsNull() || len(packages.Elements()) > 0) {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("rules").AtSetValue(rule).AtName("criteria").AtSetValue(criteria.Elements()[0]).AtName("fix_version_dependant"),
+				path.Root("rule").AtListIndex(index).AtName("criteria").AtSetValue(criteria.Elements()[0]).AtName("fix_version_dependant"),
 				"Invalid Attribute Configuration",
 				"fix_version_dependant must be set to 'false' if any package attribute is set",
 			)
@@ -548,6 +935,10 @@ func (r *SecurityPolicyResource) Delete(ctx context.Context, req resource.Delete
 	r.PolicyResource.Delete(ctx, req, resp)
 }
 
+func (r *SecurityPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.PolicyResource.ModifyPlan(ctx, req, resp)
+}
+
 // ImportState imports the resource into the Terraform state.
 func (r *SecurityPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	r.PolicyResource.ImportState(ctx, req, resp)
@@ -1,18 +1,30 @@
 package xray_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hashicorp/go-version"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+	xray "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
 )
 
 const criteriaTypeCvss = "cvss"
@@ -111,6 +123,81 @@ func TestAccSecurityPolicy_UpgradeFromSDKv2(t *testing.T) {
 	})
 }
 
+func TestAccSecurityPolicy_UpgradeRulesFromSet(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+
+	testData := sdk.MergeMaps(testDataSecurity)
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt())
+
+	config := util.ExecuteTemplate(fqrn, `
+	resource "xray_security_policy" "{{ .resource_name }}" {
+		name        = "{{ .policy_name }}"
+		description = "{{ .policy_description }}"
+		type        = "security"
+
+		rule {
+			name     = "rule-min-severity"
+			priority = 1
+			criteria {
+				min_severity = "{{ .min_severity }}"
+			}
+			actions {
+				fail_build = {{ .fail_build }}
+				block_download {
+					unscanned = {{ .block_unscanned }}
+					active    = {{ .block_active }}
+				}
+			}
+		}
+
+		rule {
+			name     = "rule-cvss"
+			priority = 2
+			criteria {
+				cvss_range {
+					from = {{ .cvss_from }}
+					to   = {{ .cvss_to }}
+				}
+			}
+			actions {
+				block_download {
+					active = false
+				}
+			}
+		}
+	}`, testData)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"xray": {
+						Source:            "jfrog/xray",
+						VersionConstraint: "3.0.7",
+					},
+				},
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(fqrn, "rule.#", "2"),
+			},
+			{
+				Config:                   config,
+				ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rule.0.name", "rule-min-severity"),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.name", "rule-cvss"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSecurityPolicy_optional_actions_attribute(t *testing.T) {
 	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
 	testData := sdk.MergeMaps(testDataSecurity)
@@ -197,6 +284,315 @@ func TestAccSecurityPolicy_multipleRules(t *testing.T) {
 	})
 }
 
+func TestAccSecurityPolicy_rulePriorityFromPosition(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+	testData := sdk.MergeMaps(testDataSecurity)
+
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt())
+	testData["rule_name_1"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["rule_name_2"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["priority_1"] = ""
+	testData["priority_2"] = ""
+
+	config := util.ExecuteTemplate(fqrn, securityPolicyTwoRulesOptionalPriorities, testData)
+
+	reorderedTestData := sdk.MergeMaps(testData)
+	reorderedTestData["rule_name_1"] = testData["rule_name_2"]
+	reorderedTestData["rule_name_2"] = testData["rule_name_1"]
+	reorderedConfig := util.ExecuteTemplate(fqrn, securityPolicyTwoRulesOptionalPriorities, reorderedTestData)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rule.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.name", testData["rule_name_1"]),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.name", testData["rule_name_2"]),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.priority", "2"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: reorderedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rule.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.name", testData["rule_name_2"]),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.name", testData["rule_name_1"]),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.priority", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecurityPolicy_explicitRulePriorities(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+	testData := sdk.MergeMaps(testDataSecurity)
+
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt())
+	testData["rule_name_1"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["rule_name_2"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["priority_1"] = "10"
+	testData["priority_2"] = "5"
+
+	config := util.ExecuteTemplate(fqrn, securityPolicyTwoRulesOptionalPriorities, testData)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rule.0.name", testData["rule_name_1"]),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.priority", "10"),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.name", testData["rule_name_2"]),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.priority", "5"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccSecurityPolicy_duplicateRulePriorities(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+	testData := sdk.MergeMaps(testDataSecurity)
+
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt())
+	testData["rule_name_1"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["rule_name_2"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["priority_1"] = "2"
+	testData["priority_2"] = ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      util.ExecuteTemplate(fqrn, securityPolicyTwoRulesOptionalPriorities, testData),
+				ExpectError: regexp.MustCompile(`.*Duplicate Rule Priority.*`),
+			},
+		},
+	})
+}
+
+func TestAccSecurityPolicy_renameAssignedToWatch(t *testing.T) {
+	_, watchFqrn, watchName := testutil.MkNames("watch-", "xray_watch")
+	policyFqrn := "xray_security_policy.security"
+
+	testData := sdk.MergeMaps(testDataWatch)
+	testData["resource_name"] = watchName
+	testData["watch_name"] = fmt.Sprintf("xray-watch-%d", testutil.RandomInt())
+	testData["policy_name_0"] = fmt.Sprintf("xray-policy-%d", testutil.RandomInt())
+
+	renamedTestData := sdk.MergeMaps(testData)
+	renamedTestData["policy_name_0"] = fmt.Sprintf("xray-policy-renamed-%d", testutil.RandomInt())
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: acctest.VerifyDeleted(watchFqrn, "name", func(id string, request *resty.Request) (*resty.Response, error) {
+			acctest.CheckPolicyDeleted(testData["policy_name_0"], t, request)
+			acctest.CheckPolicyDeleted(renamedTestData["policy_name_0"], t, request)
+			return testCheckWatch(id, request)
+		}),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: util.ExecuteTemplate(watchFqrn, allReposSinglePolicyWatchTemplate, testData),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policyFqrn, "name", testData["policy_name_0"]),
+					resource.TestCheckResourceAttr(watchFqrn, "assigned_policy.0.name", testData["policy_name_0"]),
+				),
+			},
+			{
+				Config: util.ExecuteTemplate(watchFqrn, allReposSinglePolicyWatchTemplate, renamedTestData),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(policyFqrn, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(watchFqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policyFqrn, "name", renamedTestData["policy_name_0"]),
+					resource.TestCheckResourceAttr(policyFqrn, "id", renamedTestData["policy_name_0"]),
+					resource.TestCheckResourceAttr(watchFqrn, "assigned_policy.0.name", renamedTestData["policy_name_0"]),
+				),
+			},
+			{
+				Config: util.ExecuteTemplate(watchFqrn, allReposSinglePolicyWatchTemplate, renamedTestData),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// fakePolicyRenameXray serves the policy and watch APIs used to rename a
+// policy, and fails to delete the policy named failDelete.
+type fakePolicyRenameXray struct {
+	mu               sync.Mutex
+	failDelete       string
+	policies         map[string]bool
+	watchName        string
+	assignedPolicies []xray.WatchAssignedPolicyAPIModel
+}
+
+func (x *fakePolicyRenameXray) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	watch := func() xray.WatchAPIModel {
+		return xray.WatchAPIModel{
+			GeneralData:      xray.WatchGeneralDataAPIModel{Name: x.watchName, Active: true},
+			AssignedPolicies: x.assignedPolicies,
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/xray/api/v2/watches":
+		json.NewEncoder(w).Encode([]xray.WatchAPIModel{watch()})
+	case r.Method == http.MethodGet && r.URL.Path == "/xray/api/v2/watches/"+x.watchName:
+		json.NewEncoder(w).Encode(watch())
+	case r.Method == http.MethodPut && r.URL.Path == "/xray/api/v2/watches/"+x.watchName:
+		var body xray.WatchAPIModel
+		json.NewDecoder(r.Body).Decode(&body)
+		x.assignedPolicies = body.AssignedPolicies
+	case r.Method == http.MethodPost && r.URL.Path == "/xray/api/v2/policies":
+		var body xray.PolicyAPIModel
+		json.NewDecoder(r.Body).Decode(&body)
+		x.policies[body.Name] = true
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/xray/api/v2/policies/"):
+		name := strings.TrimPrefix(r.URL.Path, "/xray/api/v2/policies/")
+		if name == x.failDelete {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": "policy is in use"}`))
+			return
+		}
+		delete(x.policies, name)
+	}
+}
+
+// securityPolicyValue returns the value of the security policy with the
+// attributes of the JSON document, the other attributes are null.
+func securityPolicyValue(t *testing.T, ctx context.Context, r fwresource.Resource, document string) (tfsdk.Plan, tfsdk.State) {
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	value, err := tftypes.ValueFromJSON([]byte(document), schemaResp.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		t.Fatalf("failed to build the policy value: %s", err)
+	}
+
+	return tfsdk.Plan{Raw: value, Schema: schemaResp.Schema}, tfsdk.State{Raw: value, Schema: schemaResp.Schema}
+}
+
+const securityPolicyDocument = `{
+	"id": "%[1]s",
+	"name": "%[1]s",
+	"project_key": %[2]s,
+	"type": "security",
+	"rule": [{
+		"name": "rule",
+		"priority": 1,
+		"criteria": [{"min_severity": "High"}],
+		"actions": [{"block_download": [{"active": false, "unscanned": false}]}]
+	}]
+}`
+
+func TestSecurityPolicy_renameRollback(t *testing.T) {
+	ctx := context.Background()
+
+	fakeXray := &fakePolicyRenameXray{
+		failDelete: "policy",
+		policies:   map[string]bool{"policy": true},
+		watchName:  "watch",
+		assignedPolicies: []xray.WatchAssignedPolicyAPIModel{
+			{Name: "policy", Type: "security"},
+		},
+	}
+	server := httptest.NewServer(fakeXray)
+	defer server.Close()
+
+	r := xray.NewSecurityPolicyResource()
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+		ProviderData: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+	}, &fwresource.ConfigureResponse{})
+
+	plan, _ := securityPolicyValue(t, ctx, r, fmt.Sprintf(securityPolicyDocument, "policy-renamed", "null"))
+	_, state := securityPolicyValue(t, ctx, r, fmt.Sprintf(securityPolicyDocument, "policy", "null"))
+
+	resp := fwresource.UpdateResponse{State: state}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: plan, State: state}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the rename to fail")
+	}
+
+	fakeXray.mu.Lock()
+	defer fakeXray.mu.Unlock()
+
+	if fakeXray.policies["policy-renamed"] {
+		t.Error("expected the renamed policy to be deleted again")
+	}
+	if !fakeXray.policies["policy"] {
+		t.Error("expected the previous policy to be kept")
+	}
+	if len(fakeXray.assignedPolicies) != 1 || fakeXray.assignedPolicies[0].Name != "policy" {
+		t.Errorf("expected the watch to be assigned the previous policy only, got %v", fakeXray.assignedPolicies)
+	}
+}
+
+func TestSecurityPolicy_renameToOtherProjectFails(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(&fakePolicyRenameXray{policies: map[string]bool{}})
+	defer server.Close()
+
+	r := xray.NewSecurityPolicyResource()
+	r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+		ProviderData: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+	}, &fwresource.ConfigureResponse{})
+
+	plan, _ := securityPolicyValue(t, ctx, r, fmt.Sprintf(securityPolicyDocument, "policy-renamed", `"project-b"`))
+	_, state := securityPolicyValue(t, ctx, r, fmt.Sprintf(securityPolicyDocument, "policy", `"project-a"`))
+
+	resp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected renaming the policy to another project to fail")
+	}
+	if summary := resp.Diagnostics.Errors()[0].Summary(); summary != "Invalid Policy Rename" {
+		t.Errorf("unexpected error: %s", summary)
+	}
+}
+
 func TestAccSecurityPolicy_unknownMinSeveritySecurityPolicy_beforeVersion3602(t *testing.T) {
 	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
 
@@ -1003,6 +1399,159 @@ func TestAccSecurityPolicy_createPackagesFail(t *testing.T) {
 	})
 }
 
+func TestAccSecurityPolicy_multiplePackages(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+	testData := sdk.MergeMaps(testDataSecurity)
+
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt())
+	testData["rule_name"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["block_unscanned"] = "false"
+	testData["block_active"] = "false"
+
+	config := util.ExecuteTemplate(fqrn, securityPolicyMultiplePackages, testData)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "rule.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.name", testData["rule_name"]),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.priority", "1"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.packages.#", "3"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.packages.0.type", "NuGet"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.packages.0.name", "nuget:RazorEngine"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.packages.0.versions.#", "2"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.packages.1.name", "lodash"),
+					resource.TestCheckResourceAttr(fqrn, "rule.0.criteria.0.packages.2.name", "golang.org/x/net"),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.name", "critical"),
+					resource.TestCheckResourceAttr(fqrn, "rule.1.priority", "2"),
+					testCheckPolicyRules(t, testData["policy_name"], map[string]int64{
+						testData["rule_name"] + "-1": 1,
+						testData["rule_name"] + "-2": 2,
+						testData["rule_name"] + "-3": 3,
+						"critical":                   4,
+					}),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+// testCheckPolicyRules checks the names and priorities of the rules of the
+// policy in Xray.
+func testCheckPolicyRules(t *testing.T, policyName string, expected map[string]int64) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var policy struct {
+			Rules []struct {
+				Name     string `json:"name"`
+				Priority int64  `json:"priority"`
+			} `json:"rules"`
+		}
+
+		resp, err := acctest.GetTestResty(t).R().
+			SetPathParam("name", policyName).
+			SetResult(&policy).
+			Get("xray/api/v2/policies/{name}")
+		if err != nil {
+			return err
+		}
+		if resp.IsError() {
+			return fmt.Errorf("failed to get policy %s: %s", policyName, resp.String())
+		}
+
+		if len(policy.Rules) != len(expected) {
+			return fmt.Errorf("expected %d rules, got %d", len(expected), len(policy.Rules))
+		}
+
+		for _, rule := range policy.Rules {
+			priority, ok := expected[rule.Name]
+			if !ok {
+				return fmt.Errorf("unexpected rule %s", rule.Name)
+			}
+			if rule.Priority != priority {
+				return fmt.Errorf("expected rule %s to have priority %d, got %d", rule.Name, priority, rule.Priority)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestAccSecurityPolicy_packagesConflictingAttributesFail(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+	testData := sdk.MergeMaps(testDataSecurity)
+
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt())
+	testData["rule_name"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["block_unscanned"] = "false"
+	testData["block_active"] = "false"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      util.ExecuteTemplate(fqrn, securityPolicyPackagesConflict, testData),
+				ExpectError: regexp.MustCompile("(?s).*Invalid Attribute Combination.*package_name.*cannot be specified when.*packages.*is specified.*"),
+			},
+		},
+	})
+}
+
+func TestAccSecurityPolicy_packagesRuleNameCollisionFails(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+	testData := sdk.MergeMaps(testDataSecurity)
+
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt())
+	testData["rule_name"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["block_unscanned"] = "false"
+	testData["block_active"] = "false"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      util.ExecuteTemplate(fqrn, securityPolicyPackagesRuleNameCollision, testData),
+				ExpectError: regexp.MustCompile("(?s).*Invalid Attribute Configuration.*rule name.*-2.*is reserved for the rules.*"),
+			},
+		},
+	})
+}
+
+func TestAccSecurityPolicy_packagesBadTypeFails(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+	testData := sdk.MergeMaps(testDataSecurity)
+
+	testData["resource_name"] = resourceName
+	testData["policy_name"] = fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt())
+	testData["rule_name"] = fmt.Sprintf("test-security-rule-%d", testutil.RandomInt())
+	testData["block_unscanned"] = "false"
+	testData["block_active"] = "false"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      util.ExecuteTemplate(fqrn, securityPolicyPackagesBadType, testData),
+				ExpectError: regexp.MustCompile("(?s).*Invalid Attribute Value Match.*packages\\[0\\]\\.type.*value must be.*one of.*"),
+			},
+		},
+	})
+}
+
 func testAccXraySecurityPolicy_badSecurityType(name, description, ruleName string, rangeTo int) string {
 	return fmt.Sprintf(`
 resource "xray_security_policy" "test" {
@@ -1293,6 +1842,45 @@ const securityPolicyTwoRules = `resource "xray_security_policy" "{{ .resource_na
 	}
 }`
 
+const securityPolicyTwoRulesOptionalPriorities = `resource "xray_security_policy" "{{ .resource_name }}" {
+	name = "{{ .policy_name }}"
+	description = "{{ .policy_description }}"
+	type = "security"
+
+	rule {
+		name = "{{ .rule_name_1 }}"
+		{{ if .priority_1 }}priority = {{ .priority_1 }}{{ end }}
+		criteria {
+			min_severity = "{{ .min_severity }}"
+		}
+		actions {
+			fail_build = {{ .fail_build }}
+			block_download {
+				unscanned = {{ .block_unscanned }}
+				active = {{ .block_active }}
+			}
+		}
+	}
+
+	rule {
+		name = "{{ .rule_name_2 }}"
+		{{ if .priority_2 }}priority = {{ .priority_2 }}{{ end }}
+		criteria {
+			cvss_range {
+				from = {{ .cvss_from }}
+				to = {{ .cvss_to }}
+			}
+		}
+		actions {
+			fail_build = {{ .fail_build }}
+			block_download {
+				unscanned = {{ .block_unscanned }}
+				active = {{ .block_active }}
+			}
+		}
+	}
+}`
+
 const securityPolicyCVSSMinSeverity = `resource "xray_security_policy" "{{ .resource_name }}" {
 	name = "{{ .policy_name }}"
 	description = "{{ .policy_description }}"
@@ -1578,3 +2166,171 @@ const securityPolicyPackages = `resource "xray_security_policy" "{{ .resource_na
 		}
 	}
 }`
+
+const securityPolicyMultiplePackages = `resource "xray_security_policy" "{{ .resource_name }}" {
+	name = "{{ .policy_name }}"
+	description = "{{ .policy_description }}"
+	type = "security"
+	rule {
+		name = "{{ .rule_name }}"
+		criteria {
+			packages {
+				type = "NuGet"
+				name = "nuget:RazorEngine"
+				versions = ["(1.2.3,3.10.2)", "[4.0.0]"]
+			}
+			packages {
+				type = "Npm"
+				name = "lodash"
+				versions = ["(,4.17.21)"]
+			}
+			packages {
+				type = "Go"
+				name = "golang.org/x/net"
+			}
+		}
+		actions {
+			fail_build = {{ .fail_build }}
+			block_download {
+				unscanned = {{ .block_unscanned }}
+				active = {{ .block_active }}
+			}
+		}
+	}
+	rule {
+		name = "critical"
+		criteria {
+			min_severity = "Critical"
+		}
+		actions {
+			fail_build = {{ .fail_build }}
+			block_download {
+				unscanned = {{ .block_unscanned }}
+				active = {{ .block_active }}
+			}
+		}
+	}
+}`
+
+const securityPolicyPackagesRuleNameCollision = `resource "xray_security_policy" "{{ .resource_name }}" {
+	name = "{{ .policy_name }}"
+	description = "{{ .policy_description }}"
+	type = "security"
+	rule {
+		name = "{{ .rule_name }}"
+		criteria {
+			packages {
+				type = "Npm"
+				name = "lodash"
+			}
+		}
+		actions {
+			block_download {
+				unscanned = {{ .block_unscanned }}
+				active = {{ .block_active }}
+			}
+		}
+	}
+	rule {
+		name = "{{ .rule_name }}-2"
+		criteria {
+			min_severity = "Critical"
+		}
+		actions {
+			block_download {
+				unscanned = {{ .block_unscanned }}
+				active = {{ .block_active }}
+			}
+		}
+	}
+}`
+
+const securityPolicyPackagesConflict = `resource "xray_security_policy" "{{ .resource_name }}" {
+	name = "{{ .policy_name }}"
+	description = "{{ .policy_description }}"
+	type = "security"
+	rule {
+		name = "{{ .rule_name }}"
+		criteria {
+			package_name = "lodash"
+			package_type = "Npm"
+			packages {
+				type = "NuGet"
+				name = "nuget:RazorEngine"
+			}
+		}
+		actions {
+			block_download {
+				unscanned = {{ .block_unscanned }}
+				active = {{ .block_active }}
+			}
+		}
+	}
+}`
+
+const securityPolicyPackagesBadType = `resource "xray_security_policy" "{{ .resource_name }}" {
+	name = "{{ .policy_name }}"
+	description = "{{ .policy_description }}"
+	type = "security"
+	rule {
+		name = "{{ .rule_name }}"
+		criteria {
+			packages {
+				type = "npm"
+				name = "lodash"
+			}
+		}
+		actions {
+			block_download {
+				unscanned = {{ .block_unscanned }}
+				active = {{ .block_active }}
+			}
+		}
+	}
+}`
+
+func TestAccSecurityPolicy_unknownWebhook(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("policy-", "xray_security_policy")
+
+	config := util.ExecuteTemplate(fqrn, `
+		resource "xray_security_policy" "{{ .resource_name }}" {
+		  name        = "{{ .policy_name }}"
+		  description = "policy created by xray acceptance tests"
+		  type        = "security"
+
+		  rule {
+		    name     = "rule-name-severity"
+		    priority = 1
+
+		    criteria {
+		      min_severity = "High"
+		    }
+
+		    actions {
+		      webhooks = ["{{ .webhook_name }}"]
+
+		      block_download {
+		        unscanned = false
+		        active    = false
+		      }
+		    }
+		  }
+		}
+	`, map[string]string{
+		"resource_name": resourceName,
+		"policy_name":   fmt.Sprintf("terraform-security-policy-%d", testutil.RandomInt()),
+		"webhook_name":  fmt.Sprintf("unknown-webhook-%d", testutil.RandomInt()),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "", acctest.CheckPolicy),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s).*Invalid reference.*Webhook 'unknown-webhook-\d+' does not exist.*`),
+			},
+		},
+	})
+}
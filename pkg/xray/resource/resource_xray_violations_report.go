@@ -24,7 +24,8 @@ var _ resource.Resource = &ViolationsReportResource{}
 func NewViolationsReportResource() resource.Resource {
 	return &ViolationsReportResource{
 		ReportResource: ReportResource{
-			TypeName: "xray_violations_report",
+			TypeName:   "xray_violations_report",
+			ReportType: "violations",
 		},
 	}
 }
@@ -142,6 +143,102 @@ func (r *ViolationsReportResource) toFiltersAPIModel(ctx context.Context, filter
 	return filters, diags
 }
 
+var violationsSecurityFiltersAttrTypes = map[string]attr.Type{
+	"cve":              types.StringType,
+	"issue_id":         types.StringType,
+	"summary_contains": types.StringType,
+	"has_remediation":  types.BoolType,
+	"cvss_score":       types.SetType{ElemType: types.ObjectType{AttrTypes: cvssScoreAttrTypes}},
+}
+
+var violationsLicenseFiltersAttrTypes = map[string]attr.Type{
+	"unknown":          types.BoolType,
+	"unrecognized":     types.BoolType,
+	"license_names":    types.SetType{ElemType: types.StringType},
+	"license_patterns": types.SetType{ElemType: types.StringType},
+}
+
+var violationsFiltersAttrTypes = map[string]attr.Type{
+	"type":             types.StringType,
+	"watch_names":      types.SetType{ElemType: types.StringType},
+	"watch_patterns":   types.SetType{ElemType: types.StringType},
+	"component":        types.StringType,
+	"artifact":         types.StringType,
+	"policy_names":     types.SetType{ElemType: types.StringType},
+	"severities":       types.SetType{ElemType: types.StringType},
+	"updated":          types.SetType{ElemType: types.ObjectType{AttrTypes: startAndEndDateAttrTypes}},
+	"security_filters": types.SetType{ElemType: types.ObjectType{AttrTypes: violationsSecurityFiltersAttrTypes}},
+	"license_filters":  types.SetType{ElemType: types.ObjectType{AttrTypes: violationsLicenseFiltersAttrTypes}},
+}
+
+func (r *ViolationsReportResource) fromFiltersAPIModel(ctx context.Context, filters *FiltersAPIModel, priorAttrs map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	securityFilters := []attr.Value{}
+	if filters.SecurityFilters != nil {
+		priorSecurityFiltersAttrs := firstElementAttrs(priorAttrs["security_filters"])
+
+		securityFilter, d := types.ObjectValue(
+			violationsSecurityFiltersAttrTypes,
+			map[string]attr.Value{
+				"cve":              types.StringValue(filters.SecurityFilters.Cve),
+				"issue_id":         types.StringValue(filters.SecurityFilters.IssueId),
+				"summary_contains": stringValueOrNull(filters.SecurityFilters.SummaryContains),
+				"has_remediation":  boolValueOrPrior(filters.SecurityFilters.HasRemediation, priorSecurityFiltersAttrs["has_remediation"]),
+				"cvss_score":       cvssScoreSetValue(filters.SecurityFilters.CVSSScore, priorSecurityFiltersAttrs["cvss_score"]),
+			},
+		)
+		if d.HasError() {
+			diags.Append(d...)
+		}
+
+		securityFilters = append(securityFilters, securityFilter)
+	}
+
+	licenseFilters := []attr.Value{}
+	if filters.LicenseFilters != nil {
+		licenseFilter, d := types.ObjectValue(
+			violationsLicenseFiltersAttrTypes,
+			map[string]attr.Value{
+				"unknown":          types.BoolValue(filters.LicenseFilters.Unknown),
+				"unrecognized":     types.BoolValue(filters.LicenseFilters.Unrecognized),
+				"license_names":    stringSetValue(filters.LicenseFilters.LicenseNames),
+				"license_patterns": stringSetValue(filters.LicenseFilters.LicensePatterns),
+			},
+		)
+		if d.HasError() {
+			diags.Append(d...)
+		}
+
+		licenseFilters = append(licenseFilters, licenseFilter)
+	}
+
+	filtersObject, d := types.ObjectValue(
+		violationsFiltersAttrTypes,
+		map[string]attr.Value{
+			"type":             stringValueOrNull(filters.Type),
+			"watch_names":      stringSetValue(filters.WatchNames),
+			"watch_patterns":   stringSetValue(filters.WatchPatterns),
+			"component":        stringValueOrNull(filters.Component),
+			"artifact":         stringValueOrNull(filters.Artifact),
+			"policy_names":     stringSetValue(filters.PolicyNames),
+			"severities":       stringSetValue(filters.Severities),
+			"updated":          startAndEndDateSetValue(filters.Updated),
+			"security_filters": types.SetValueMust(types.ObjectType{AttrTypes: violationsSecurityFiltersAttrTypes}, securityFilters),
+			"license_filters":  types.SetValueMust(types.ObjectType{AttrTypes: violationsLicenseFiltersAttrTypes}, licenseFilters),
+		},
+	)
+	if d.HasError() {
+		diags.Append(d...)
+	}
+
+	return filtersObject, diags
+}
+
+func (r ViolationsReportResource) fromAPIModel(ctx context.Context, report ReportAPIModel, state *ReportResourceModel) diag.Diagnostics {
+	return state.fromAPIModel(ctx, report, r.fromFiltersAPIModel)
+}
+
 func (r ViolationsReportResource) toAPIModel(ctx context.Context, plan ReportResourceModel, report *ReportAPIModel) diag.Diagnostics {
 	return plan.toAPIModel(ctx, report, r.toFiltersAPIModel)
 }
@@ -375,15 +472,19 @@ func (r *ViolationsReportResource) Configure(ctx context.Context, req resource.C
 }
 
 func (r *ViolationsReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	r.ReportResource.Create(ctx, "violations", r.toAPIModel, req, resp)
+	r.ReportResource.Create(ctx, r.toAPIModel, req, resp)
 }
 
 func (r *ViolationsReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	r.ReportResource.Read(ctx, req, resp)
+	r.ReportResource.Read(ctx, r.fromAPIModel, req, resp)
 }
 
 func (r *ViolationsReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	r.ReportResource.Update(ctx, "violations", r.toAPIModel, req, resp)
+	r.ReportResource.Update(ctx, req, resp)
+}
+
+func (r *ViolationsReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ReportResource.ImportState(ctx, req, resp)
 }
 
 func (r *ViolationsReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
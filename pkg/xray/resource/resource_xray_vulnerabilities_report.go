@@ -24,7 +24,8 @@ var _ resource.Resource = &VulnerabilitiesReportResource{}
 func NewVulnerabilitiesReportResource() resource.Resource {
 	return &VulnerabilitiesReportResource{
 		ReportResource: ReportResource{
-			TypeName: "xray_vulnerabilities_report",
+			TypeName:   "xray_vulnerabilities_report",
+			ReportType: "vulnerabilities",
 		},
 	}
 }
@@ -57,6 +58,28 @@ func (r *VulnerabilitiesReportResource) toFiltersAPIModel(ctx context.Context, f
 			diags.Append(d...)
 		}
 
+		var published *StartAndEndDateAPIModel
+		publishedElems := attrs["published"].(types.Set).Elements()
+		if len(publishedElems) > 0 {
+			attrs := publishedElems[0].(types.Object).Attributes()
+
+			published = &StartAndEndDateAPIModel{
+				Start: attrs["start"].(types.String).ValueString(),
+				End:   attrs["end"].(types.String).ValueString(),
+			}
+		}
+
+		var scanDate *StartAndEndDateAPIModel
+		scanDateElems := attrs["scan_date"].(types.Set).Elements()
+		if len(scanDateElems) > 0 {
+			attrs := scanDateElems[0].(types.Object).Attributes()
+
+			scanDate = &StartAndEndDateAPIModel{
+				Start: attrs["start"].(types.String).ValueString(),
+				End:   attrs["end"].(types.String).ValueString(),
+			}
+		}
+
 		filters = &FiltersAPIModel{
 			VulnerableComponent: attrs["vulnerable_component"].(types.String).ValueString(),
 			ImpactedArtifact:    attrs["impacted_artifact"].(types.String).ValueString(),
@@ -65,12 +88,47 @@ func (r *VulnerabilitiesReportResource) toFiltersAPIModel(ctx context.Context, f
 			IssueId:             attrs["issue_id"].(types.String).ValueString(),
 			Severities:          severities,
 			CVSSScore:           cvssScore,
+			Published:           published,
+			ScanDate:            scanDate,
 		}
 	}
 
 	return filters, diags
 }
 
+var vulnerabilitiesFiltersAttrTypes = map[string]attr.Type{
+	"vulnerable_component": types.StringType,
+	"impacted_artifact":    types.StringType,
+	"has_remediation":      types.BoolType,
+	"cve":                  types.StringType,
+	"issue_id":             types.StringType,
+	"severities":           types.SetType{ElemType: types.StringType},
+	"cvss_score":           types.SetType{ElemType: types.ObjectType{AttrTypes: cvssScoreAttrTypes}},
+	"published":            types.SetType{ElemType: types.ObjectType{AttrTypes: startAndEndDateAttrTypes}},
+	"scan_date":            types.SetType{ElemType: types.ObjectType{AttrTypes: startAndEndDateAttrTypes}},
+}
+
+func (r *VulnerabilitiesReportResource) fromFiltersAPIModel(ctx context.Context, filters *FiltersAPIModel, priorAttrs map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	return types.ObjectValue(
+		vulnerabilitiesFiltersAttrTypes,
+		map[string]attr.Value{
+			"vulnerable_component": stringValueOrNull(filters.VulnerableComponent),
+			"impacted_artifact":    stringValueOrNull(filters.ImpactedArtifact),
+			"has_remediation":      types.BoolValue(filters.HasRemediation),
+			"cve":                  stringValueOrNull(filters.CVE),
+			"issue_id":             types.StringValue(filters.IssueId),
+			"severities":           stringSetValue(filters.Severities),
+			"cvss_score":           cvssScoreSetValue(filters.CVSSScore, priorAttrs["cvss_score"]),
+			"published":            startAndEndDateSetValue(filters.Published),
+			"scan_date":            startAndEndDateSetValue(filters.ScanDate),
+		},
+	)
+}
+
+func (r VulnerabilitiesReportResource) fromAPIModel(ctx context.Context, report ReportAPIModel, state *ReportResourceModel) diag.Diagnostics {
+	return state.fromAPIModel(ctx, report, r.fromFiltersAPIModel)
+}
+
 func (r VulnerabilitiesReportResource) toAPIModel(ctx context.Context, plan ReportResourceModel, report *ReportAPIModel) diag.Diagnostics {
 	return plan.toAPIModel(ctx, report, r.toFiltersAPIModel)
 }
@@ -237,15 +295,19 @@ func (r *VulnerabilitiesReportResource) Configure(ctx context.Context, req resou
 }
 
 func (r *VulnerabilitiesReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	r.ReportResource.Create(ctx, "vulnerabilities", r.toAPIModel, req, resp)
+	r.ReportResource.Create(ctx, r.toAPIModel, req, resp)
 }
 
 func (r *VulnerabilitiesReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	r.ReportResource.Read(ctx, req, resp)
+	r.ReportResource.Read(ctx, r.fromAPIModel, req, resp)
 }
 
 func (r *VulnerabilitiesReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	r.ReportResource.Update(ctx, "vulnerabilities", r.toAPIModel, req, resp)
+	r.ReportResource.Update(ctx, req, resp)
+}
+
+func (r *VulnerabilitiesReportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.ReportResource.ImportState(ctx, req, resp)
 }
 
 func (r *VulnerabilitiesReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
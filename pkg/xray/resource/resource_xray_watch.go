@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -46,6 +47,7 @@ var supportedResourceTypes = []string{
 }
 
 var _ resource.Resource = &WatchResource{}
+var _ resource.ResourceWithModifyPlan = &WatchResource{}
 
 func NewWatchResource() resource.Resource {
 	return &WatchResource{
@@ -72,6 +74,11 @@ type WatchResourceModel struct {
 	WatchRecipients  types.Set    `tfsdk:"watch_recipients"`
 }
 
+type watchResourceStateModel struct {
+	WatchResourceModel
+	IgnoreExternalPolicies types.Bool `tfsdk:"ignore_external_policies"`
+}
+
 func unpackAntFilter(ctx context.Context, filterType string, ds *diag.Diagnostics) func(elem attr.Value, _ int) WatchFilterAPIModel {
 	return func(elem attr.Value, _ int) WatchFilterAPIModel {
 		attrs := elem.(types.Object).Attributes()
@@ -389,10 +396,11 @@ func (m *WatchResourceModel) fromAPIModel(ctx context.Context, apiModel WatchAPI
 			for _, filter := range property.Filters {
 				packFilterAttribute, ok := packFilterMap[filter.Type]
 				if !ok {
-					diags.AddError(
-						"invalid filter.Type",
-						filter.Type,
+					diags.AddWarning(
+						"Unsupported Watch Filter Type",
+						fmt.Sprintf("Filter type '%s' of watch '%s' is not supported by the provider and is skipped.", filter.Type, apiModel.GeneralData.Name),
 					)
+					continue
 				}
 
 				packedFilter, d := packFilterAttribute["func"].(func(ctx context.Context, filter WatchFilterAPIModel) (attr.Value, diag.Diagnostics))(ctx, filter)
@@ -480,6 +488,22 @@ func (m *WatchResourceModel) fromAPIModel(ctx context.Context, apiModel WatchAPI
 	return diags
 }
 
+// FromAPIModel maps the watch into the model, packing the filters of each
+// watch resource the same way as the xray_watch resource does.
+func (m *WatchResourceModel) FromAPIModel(ctx context.Context, apiModel WatchAPIModel) diag.Diagnostics {
+	return m.fromAPIModel(ctx, apiModel)
+}
+
+// assignedPolicyNames returns the names of the assigned policies of the model.
+func (m WatchResourceModel) assignedPolicyNames() []string {
+	return lo.Map(
+		m.AssignedPolicies.Elements(),
+		func(elem attr.Value, _ int) string {
+			return elem.(types.Object).Attributes()["name"].(types.String).ValueString()
+		},
+	)
+}
+
 type WatchGeneralDataAPIModel struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
@@ -567,6 +591,12 @@ func (r *WatchResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 				Description: "A list of email addressed that will get emailed when a violation is triggered.",
 			},
+			"ignore_external_policies": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When set to `true`, policies assigned to the watch outside of this resource, e.g. with `xray_watch_policy_assignment`, are neither reported as drift nor removed on update. Only the policies of `assigned_policy` are managed by this resource, and the watch settings not supported by this resource are kept on update. Default value is `false`.",
+			},
 		},
 		Blocks: map[string]schema.Block{
 			"watch_resource": schema.SetNestedBlock{
@@ -733,7 +763,7 @@ func (r *WatchResource) Configure(ctx context.Context, req resource.ConfigureReq
 func (r *WatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var plan WatchResourceModel
+	var plan watchResourceStateModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -757,6 +787,13 @@ func (r *WatchResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	checkReferences(&resp.Diagnostics, true, func() ([]invalidReference, error) {
+		return invalidAssignedPolicies(r.ProviderData.Client, nil, projectKey, plan.AssignedPolicies, types.SetNull(plan.AssignedPolicies.ElementType(ctx)))
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// add 'build_repo' to resource if project_key is specified.
 	// undocumented Xray API structure that is required!
 	if len(plan.ProjectKey.ValueString()) > 0 {
@@ -786,7 +823,7 @@ func (r *WatchResource) Create(ctx context.Context, req resource.CreateRequest,
 func (r *WatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var plan WatchResourceModel
+	var plan watchResourceStateModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -810,6 +847,13 @@ func (r *WatchResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	checkReferences(&resp.Diagnostics, true, func() ([]invalidReference, error) {
+		return invalidAssignedPolicies(r.ProviderData.Client, nil, projectKey, plan.AssignedPolicies, types.SetNull(plan.AssignedPolicies.ElementType(ctx)))
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// add 'build_repo' to resource if project_key is specified.
 	// undocumented Xray API structure that is required!
 	if len(plan.ProjectKey.ValueString()) > 0 {
@@ -820,9 +864,45 @@ func (r *WatchResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
+	var body any = watch
+
+	// Keep the policies assigned outside of this resource, i.e. assigned in
+	// Xray but neither in the prior state nor in the plan, as well as the
+	// settings of the watch which are not managed by this resource.
+	if plan.IgnoreExternalPolicies.ValueBool() {
+		defer lockWatch(projectKey, plan.Name.ValueString())()
+
+		var state watchResourceStateModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		remoteWatch, raw, err := getWatch(r.ProviderData.Client, projectKey, plan.Name.ValueString())
+		if err != nil {
+			utilfw.UnableToUpdateResourceError(resp, err.Error())
+			return
+		}
+
+		if remoteWatch != nil {
+			managedPolicies := append(state.assignedPolicyNames(), plan.assignedPolicyNames()...)
+			externalPolicies := lo.Reject(remoteWatch.AssignedPolicies, func(policy WatchAssignedPolicyAPIModel, _ int) bool {
+				return lo.Contains(managedPolicies, policy.Name)
+			})
+			watch.AssignedPolicies = append(watch.AssignedPolicies, externalPolicies...)
+
+			merged, err := raw.merge(watch)
+			if err != nil {
+				utilfw.UnableToUpdateResourceError(resp, err.Error())
+				return
+			}
+			body = merged
+		}
+	}
+
 	response, err := request.
 		SetPathParam("name", plan.Name.ValueString()).
-		SetBody(watch).
+		SetBody(body).
 		Put(WatchEndpoint)
 	if err != nil {
 		utilfw.UnableToUpdateResourceError(resp, err.Error())
@@ -840,7 +920,7 @@ func (r *WatchResource) Update(ctx context.Context, req resource.UpdateRequest,
 func (r *WatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var state WatchResourceModel
+	var state watchResourceStateModel
 
 	// Read Terraform state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -878,6 +958,19 @@ func (r *WatchResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	if state.IgnoreExternalPolicies.IsNull() {
+		state.IgnoreExternalPolicies = types.BoolValue(false)
+	}
+
+	// Only report the policies managed by this resource. The assigned policies
+	// are null after import, then all of them are managed.
+	if state.IgnoreExternalPolicies.ValueBool() && !state.AssignedPolicies.IsNull() {
+		managedPolicies := state.assignedPolicyNames()
+		watch.AssignedPolicies = lo.Filter(watch.AssignedPolicies, func(policy WatchAssignedPolicyAPIModel, _ int) bool {
+			return lo.Contains(managedPolicies, policy.Name)
+		})
+	}
+
 	resp.Diagnostics.Append(state.fromAPIModel(ctx, watch)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -890,7 +983,7 @@ func (r *WatchResource) Read(ctx context.Context, req resource.ReadRequest, resp
 func (r *WatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
 
-	var state WatchResourceModel
+	var state watchResourceStateModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -940,8 +1033,43 @@ func (r *WatchResource) ImportState(ctx context.Context, req resource.ImportStat
 	}
 }
 
+// ModifyPlan looks up the assigned policies, to report policies which don't
+// exist or don't match the type when planning instead of failing the apply.
+// Only the assignments not in the state are looked up, once per provider
+// instance.
+func (r *WatchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed, or the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.ProviderData.Client == nil {
+		return
+	}
+
+	var plan watchResourceStateModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProjectKey.IsUnknown() {
+		return
+	}
+
+	previousPolicies := types.SetNull(plan.AssignedPolicies.ElementType(ctx))
+	if !req.State.Raw.IsNull() {
+		var state watchResourceStateModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The policies are looked up in the project of the watch.
+		if state.ProjectKey.ValueString() == plan.ProjectKey.ValueString() {
+			previousPolicies = state.AssignedPolicies
+		}
+	}
+
+	checkReferences(&resp.Diagnostics, false, func() ([]invalidReference, error) {
+		return invalidAssignedPolicies(r.ProviderData.Client, referenceCacheFor(r.ProviderData.Client), plan.ProjectKey.ValueString(), plan.AssignedPolicies, previousPolicies)
+	})
+}
+
 func (r WatchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var config WatchResourceModel
+	var config watchResourceStateModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,331 @@
+package xray
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+const ApplyWatchEndpoint = "xray/api/v1/applyWatch"
+
+var _ resource.Resource = &WatchHistoricalScanResource{}
+var _ resource.ResourceWithModifyPlan = &WatchHistoricalScanResource{}
+
+func NewWatchHistoricalScanResource() resource.Resource {
+	return &WatchHistoricalScanResource{
+		TypeName: "xray_watch_historical_scan",
+	}
+}
+
+type WatchHistoricalScanResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+func (r *WatchHistoricalScanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+type WatchHistoricalScanResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ProjectKey         types.String `tfsdk:"project_key"`
+	WatchNames         types.Set    `tfsdk:"watch_names"`
+	StartDate          types.String `tfsdk:"start_date"`
+	EndDate            types.String `tfsdk:"end_date"`
+	Triggers           types.Map    `tfsdk:"triggers"`
+	WatchesFingerprint types.String `tfsdk:"watches_fingerprint"`
+	LastAppliedAt      types.String `tfsdk:"last_applied_at"`
+}
+
+type ApplyWatchAPIModel struct {
+	WatchNames []string                    `json:"watch_names"`
+	DateRange  ApplyWatchDateRangeAPIModel `json:"date_range"`
+}
+
+type ApplyWatchDateRangeAPIModel struct {
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+func (m WatchHistoricalScanResourceModel) watchNames(ctx context.Context) ([]string, diag.Diagnostics) {
+	var watchNames []string
+	diags := m.WatchNames.ElementsAs(ctx, &watchNames, false)
+	slices.Sort(watchNames)
+
+	return watchNames, diags
+}
+
+func (m WatchHistoricalScanResourceModel) toAPIModel(ctx context.Context, apiModel *ApplyWatchAPIModel) diag.Diagnostics {
+	watchNames, diags := m.watchNames(ctx)
+
+	// The scan covers the content indexed until now when no end date is set.
+	endDate := m.EndDate.ValueString()
+	if endDate == "" {
+		endDate = time.Now().Format(time.RFC3339)
+	}
+
+	*apiModel = ApplyWatchAPIModel{
+		WatchNames: watchNames,
+		DateRange: ApplyWatchDateRangeAPIModel{
+			StartDate: m.StartDate.ValueString(),
+			EndDate:   endDate,
+		},
+	}
+
+	return diags
+}
+
+func (r *WatchHistoricalScanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: lo.Assign(
+			projectKeySchemaAttrs(false, "Must be the project key of the watches."),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:    true,
+					Description: "ID of the historical scan, derived from the watch names.",
+				},
+				"watch_names": schema.SetAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					},
+					Description: "Names of the watches to apply to the existing content.",
+				},
+				"start_date": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						IsRFC3339Time(),
+					},
+					Description: "Start of the date range of the content to scan, in RFC3339 format, e.g. `2025-01-01T00:00:00Z`.",
+				},
+				"end_date": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						IsRFC3339Time(),
+					},
+					Description: "End of the date range of the content to scan, in RFC3339 format, e.g. `2025-01-31T00:00:00Z`. When not set, the content indexed until the scan is triggered is scanned.",
+				},
+				"triggers": schema.MapAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Arbitrary map of values which trigger a new scan when changed. Must reference the watches managed in the same configuration, e.g. `{ watch = sha1(jsonencode(xray_watch.my-watch)) }`, to scan again in the same apply as a change of the watch, otherwise the change is only scanned by the next apply.",
+				},
+				"watches_fingerprint": schema.StringAttribute{
+					Computed:    true,
+					Description: "Fingerprint of the resources and assigned policies of the watches at the last scan. A new scan is planned when the watches differ from this fingerprint.",
+				},
+				"last_applied_at": schema.StringAttribute{
+					Computed:    true,
+					Description: "Time when the last scan was triggered.",
+				},
+			},
+		),
+		MarkdownDescription: "Applies watches to the content indexed within a date range, so existing artifacts and builds are evaluated against the watches and their policies. " +
+			"New watches otherwise only evaluate newly indexed content. " +
+			"The scan is triggered on create and again whenever the attributes of this resource, the resources or the assigned policies of the watches change. " +
+			"Changes of the watches are detected when planning, from the watches in Xray, so a watch changed in the same apply is only scanned again by the next apply, unless `triggers` references the watch. " +
+			"Destroying this resource does not undo the scan. " +
+			"See JFrog [Apply Watch on Existing Content API documentation](https://jfrog.com/help/r/xray-rest-apis/apply-watch-on-existing-content) for more details.",
+	}
+}
+
+func (r *WatchHistoricalScanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// watchesFingerprint hashes the resources and assigned policies of the
+// watches, to detect changes of the watches scanned. Watches which don't
+// exist are part of the fingerprint by name only.
+func (r *WatchHistoricalScanResource) watchesFingerprint(projectKey string, watchNames []string) (string, error) {
+	hash := sha256.New()
+
+	for _, name := range watchNames {
+		watch, _, err := getWatch(r.ProviderData.Client, projectKey, name)
+		if err != nil {
+			return "", err
+		}
+
+		var scanned interface{}
+		if watch != nil {
+			policies := slices.Clone(watch.AssignedPolicies)
+			slices.SortFunc(policies, func(a, b WatchAssignedPolicyAPIModel) int {
+				return strings.Compare(a.Name, b.Name)
+			})
+
+			scanned = struct {
+				Resources        []WatchProjectResourceAPIModel `json:"resources"`
+				AssignedPolicies []WatchAssignedPolicyAPIModel  `json:"assigned_policies"`
+			}{
+				Resources:        watch.ProjectResources.Resources,
+				AssignedPolicies: policies,
+			}
+		}
+
+		watchJSON, err := json.Marshal(scanned)
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(hash, "%s=%s\n", name, watchJSON)
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), nil
+}
+
+// applyWatches triggers the scan and sets the computed attributes of the model.
+func (r *WatchHistoricalScanResource) applyWatches(ctx context.Context, m *WatchHistoricalScanResourceModel) error {
+	var applyWatch ApplyWatchAPIModel
+	if diags := m.toAPIModel(ctx, &applyWatch); diags.HasError() {
+		return fmt.Errorf("failed to read watch names")
+	}
+
+	request, err := getRestyRequest(r.ProviderData.Client, m.ProjectKey.ValueString())
+	if err != nil {
+		return err
+	}
+
+	response, err := request.
+		SetBody(applyWatch).
+		Post(ApplyWatchEndpoint)
+	if err != nil {
+		return err
+	}
+
+	if response.IsError() {
+		return fmt.Errorf("%s", response.String())
+	}
+
+	fingerprint, err := r.watchesFingerprint(m.ProjectKey.ValueString(), applyWatch.WatchNames)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256([]byte(strings.Join(applyWatch.WatchNames, ",")))
+	m.ID = types.StringValue(fmt.Sprintf("%x", hash))
+	m.WatchesFingerprint = types.StringValue(fingerprint)
+	m.LastAppliedAt = types.StringValue(time.Now().Format(time.RFC3339))
+
+	return nil
+}
+
+func (r *WatchHistoricalScanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan WatchHistoricalScanResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyWatches(ctx, &plan); err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *WatchHistoricalScanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	// The scan has no state in Xray, the changes of the watches are detected
+	// when planning.
+}
+
+func (r *WatchHistoricalScanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	go util.SendUsageResourceUpdate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan WatchHistoricalScanResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.applyWatches(ctx, &plan); err != nil {
+		utilfw.UnableToUpdateResourceError(resp, err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *WatchHistoricalScanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	// A scan can't be undone, the resource is only removed from the state.
+}
+
+// ModifyPlan plans a new scan when the resources or the assigned policies of
+// the watches changed since the last scan.
+func (r *WatchHistoricalScanResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being created or destroyed, nothing to plan.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan WatchHistoricalScanResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state WatchHistoricalScanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The computed attributes are unknown when the configuration changed, a
+	// new scan is planned already.
+	if plan.WatchesFingerprint.IsUnknown() || plan.WatchNames.IsUnknown() || plan.ProjectKey.IsUnknown() {
+		return
+	}
+
+	watchNames, diags := plan.watchNames(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fingerprint, err := r.watchesFingerprint(plan.ProjectKey.ValueString(), watchNames)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to read watches",
+			fmt.Sprintf("Changes of the watches since the last scan can't be detected: %s", err),
+		)
+		return
+	}
+
+	if fingerprint != state.WatchesFingerprint.ValueString() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("watches_fingerprint"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_applied_at"), types.StringUnknown())...)
+	}
+}
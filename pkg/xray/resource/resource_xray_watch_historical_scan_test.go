@@ -0,0 +1,209 @@
+package xray_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+)
+
+const watchHistoricalScanTemplate = `resource "xray_security_policy" "security" {
+  name        = "{{ .policy_name }}"
+  description = "Security policy description"
+  type        = "security"
+  rule {
+    name     = "rule-name-severity"
+    priority = 1
+    criteria {
+      min_severity = "High"
+    }
+    actions {
+      block_download {
+        unscanned = true
+        active    = true
+      }
+      fail_build = true
+    }
+  }
+}
+
+resource "xray_watch" "{{ .resource_name }}" {
+  name        = "{{ .watch_name }}"
+  description = "{{ .description }}"
+  active      = true
+
+  watch_resource {
+    type = "all-repos"
+    {{ .watch_filter }}
+  }
+
+  assigned_policy {
+    name = xray_security_policy.security.name
+    type = "security"
+  }
+}
+
+resource "xray_watch_historical_scan" "{{ .resource_name }}" {
+  watch_names = [xray_watch.{{ .resource_name }}.name]
+  start_date  = "{{ .start_date }}"
+  triggers = {
+    watch = sha1(jsonencode(xray_watch.{{ .resource_name }}))
+  }
+}`
+
+func TestAccWatchHistoricalScan_full(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("watch-historical-scan-", "xray_watch_historical_scan")
+	watchFqrn := "xray_watch." + resourceName
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"watch_name":    fmt.Sprintf("xray-watch-%d", testutil.RandomInt()),
+		"policy_name":   fmt.Sprintf("xray-policy-%d", testutil.RandomInt()),
+		"description":   "watch created by xray acceptance tests",
+		"start_date":    time.Now().AddDate(0, -1, 0).Format(time.RFC3339),
+		"watch_filter":  "",
+	}
+	config := util.ExecuteTemplate(fqrn, watchHistoricalScanTemplate, testData)
+
+	testData["description"] = "watch updated by xray acceptance tests"
+	updatedConfig := util.ExecuteTemplate(fqrn, watchHistoricalScanTemplate, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.VerifyDeleted(watchFqrn, "name", func(id string, request *resty.Request) (*resty.Response, error) {
+			acctest.CheckPolicyDeleted(testData["policy_name"], t, request)
+			return testCheckWatch(id, request)
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "id"),
+					resource.TestCheckResourceAttr(fqrn, "watch_names.#", "1"),
+					resource.TestCheckResourceAttr(fqrn, "watch_names.0", testData["watch_name"]),
+					resource.TestCheckResourceAttr(fqrn, "start_date", testData["start_date"]),
+					resource.TestCheckResourceAttrSet(fqrn, "watches_fingerprint"),
+					resource.TestCheckResourceAttrSet(fqrn, "last_applied_at"),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(fqrn, "last_applied_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccWatchHistoricalScan_watchChangedInSameApply(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("watch-historical-scan-", "xray_watch_historical_scan")
+	watchFqrn := "xray_watch." + resourceName
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"watch_name":    fmt.Sprintf("xray-watch-%d", testutil.RandomInt()),
+		"policy_name":   fmt.Sprintf("xray-policy-%d", testutil.RandomInt()),
+		"description":   "watch created by xray acceptance tests",
+		"start_date":    time.Now().AddDate(0, -1, 0).Format(time.RFC3339),
+		"watch_filter":  "",
+	}
+	config := util.ExecuteTemplate(fqrn, watchHistoricalScanTemplate, testData)
+
+	testData["watch_filter"] = `filter {
+      type  = "regex"
+      value = ".*"
+    }`
+	updatedConfig := util.ExecuteTemplate(fqrn, watchHistoricalScanTemplate, testData)
+
+	var fingerprint string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.VerifyDeleted(watchFqrn, "name", func(id string, request *resty.Request) (*resty.Response, error) {
+			acctest.CheckPolicyDeleted(testData["policy_name"], t, request)
+			return testCheckWatch(id, request)
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.TestCheckResourceAttrWith(fqrn, "watches_fingerprint", func(value string) error {
+					fingerprint = value
+					return nil
+				}),
+			},
+			{
+				// The resources of the watch change, the scan happens in the
+				// same apply through the triggers.
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(watchFqrn, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(fqrn, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrWith(fqrn, "watches_fingerprint", func(value string) error {
+					if value == fingerprint {
+						return fmt.Errorf("expected the fingerprint of the changed watch, got the previous one")
+					}
+					return nil
+				}),
+			},
+			{
+				// The scan already covers the change, nothing is left for the
+				// next plan.
+				Config: updatedConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccWatchHistoricalScan_invalid_start_date(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("watch-historical-scan-", "xray_watch_historical_scan")
+
+	config := util.ExecuteTemplate(fqrn, `
+		resource "xray_watch_historical_scan" "{{ .name }}" {
+		  watch_names = ["fake-watch"]
+		  start_date  = "2025-01-01"
+		}
+	`, map[string]string{
+		"name": resourceName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*must be a valid RFC3339 date.*`),
+			},
+		},
+	})
+}
@@ -0,0 +1,453 @@
+package xray
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jfrog/terraform-provider-shared/util"
+	utilfw "github.com/jfrog/terraform-provider-shared/util/fw"
+	"github.com/samber/lo"
+)
+
+// watchPolicyAssignmentMaxRetries is the number of read-modify-write retries
+// made to assign or unassign a policy, after the first attempt, before giving
+// up.
+const watchPolicyAssignmentMaxRetries = 5
+
+// watchPolicyAssignmentRetryInterval is the delay before retrying an update of
+// the watch which was rejected or overwritten by a concurrent update. It grows
+// with each attempt.
+var watchPolicyAssignmentRetryInterval = 2 * time.Second
+
+// watchLocks serializes the updates of a watch made by this provider process,
+// keyed by project key and watch name. Other processes updating the same watch
+// are only detected by reading the watch back.
+var watchLocks sync.Map
+
+func lockWatch(projectKey, name string) func() {
+	lock, _ := watchLocks.LoadOrStore(projectKey+"/"+name, &sync.Mutex{})
+	mutex := lock.(*sync.Mutex)
+	mutex.Lock()
+
+	return mutex.Unlock
+}
+
+var _ resource.Resource = &WatchPolicyAssignmentResource{}
+var _ resource.ResourceWithModifyPlan = &WatchPolicyAssignmentResource{}
+
+func NewWatchPolicyAssignmentResource() resource.Resource {
+	return &WatchPolicyAssignmentResource{
+		TypeName: "xray_watch_policy_assignment",
+	}
+}
+
+type WatchPolicyAssignmentResource struct {
+	ProviderData util.ProviderMetadata
+	TypeName     string
+}
+
+func (r *WatchPolicyAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.TypeName
+}
+
+type WatchPolicyAssignmentResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ProjectKey types.String `tfsdk:"project_key"`
+	WatchName  types.String `tfsdk:"watch_name"`
+	PolicyName types.String `tfsdk:"policy_name"`
+	PolicyType types.String `tfsdk:"policy_type"`
+}
+
+func (m WatchPolicyAssignmentResourceModel) toAPIModel() WatchAssignedPolicyAPIModel {
+	return WatchAssignedPolicyAPIModel{
+		Name: m.PolicyName.ValueString(),
+		Type: m.PolicyType.ValueString(),
+	}
+}
+
+func (r *WatchPolicyAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: lo.Assign(
+			projectKeySchemaAttrs(true, "Must be the project key of the watch."),
+			map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+					Description: "ID of the assignment, in the format `watch_name:policy_name`.",
+				},
+				"watch_name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Description: "Name of the existing watch to assign the policy to.",
+				},
+				"policy_name": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Description: "Name of the policy to assign to the watch.",
+				},
+				"policy_type": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("security", "license", "operational_risk"),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+					Description: "The type of the policy - security, license or operational risk",
+				},
+			},
+		),
+		MarkdownDescription: "Assigns a single policy to an existing watch, so the policies of a watch can be managed separately from the watch itself, e.g. by the teams owning the policies. " +
+			"The watch is updated with a read-modify-write of its assigned policies and read back, and the update is retried when it was lost to a concurrent update of the watch. Only the updates made by the same provider run are serialized, updates from other Terraform runs or Xray clients are only detected by reading the watch back, as the watch API has no concurrency control. " +
+			"The policy is looked up when planning, and a policy which doesn't exist or doesn't match `policy_type` is only reported as a warning, as it may be created earlier in the same apply. The apply then fails before the watch is changed. Set `ignore_external_policies` to `true` on the `xray_watch` resource managing the watch, otherwise it removes the policies assigned with this resource.",
+	}
+}
+
+func (r *WatchPolicyAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	r.ProviderData = req.ProviderData.(util.ProviderMetadata)
+}
+
+// rawWatch is the watch as returned by Xray, to update it without dropping the
+// settings which are not part of WatchAPIModel.
+type rawWatch map[string]json.RawMessage
+
+// getWatch fetches the watch, decoded and as is. The returned watch is nil if
+// it does not exist.
+func getWatch(client *resty.Client, projectKey, name string) (*WatchAPIModel, rawWatch, error) {
+	request, err := getRestyRequest(client, projectKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	response, err := request.
+		SetPathParam("name", name).
+		Get(WatchEndpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if response.StatusCode() == http.StatusNotFound {
+		return nil, nil, nil
+	}
+
+	if response.IsError() {
+		return nil, nil, fmt.Errorf("%s", response.String())
+	}
+
+	var watch WatchAPIModel
+	if err := json.Unmarshal(response.Body(), &watch); err != nil {
+		return nil, nil, err
+	}
+
+	var raw rawWatch
+	if err := json.Unmarshal(response.Body(), &raw); err != nil {
+		return nil, nil, err
+	}
+
+	return &watch, raw, nil
+}
+
+// merge sets the fields of the watch in the raw watch, keeping the other
+// fields as they are.
+func (w rawWatch) merge(watch any) (rawWatch, error) {
+	body, err := json.Marshal(watch)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields rawWatch
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+
+	merged := make(rawWatch, len(w)+len(fields))
+	maps.Copy(merged, w)
+	maps.Copy(merged, fields)
+
+	return merged, nil
+}
+
+// tryAssignWatchPolicy makes one read-modify-write attempt to assign or
+// unassign the policy, and reads the watch back after updating it. It returns
+// true when the watch is in the expected state, and false when the update was
+// rejected or lost to a concurrent update.
+func tryAssignWatchPolicy(client *resty.Client, projectKey, watchName string, policy WatchAssignedPolicyAPIModel, assign bool) (bool, error) {
+	defer lockWatch(projectKey, watchName)()
+
+	watch, raw, err := getWatch(client, projectKey, watchName)
+	if err != nil {
+		return false, err
+	}
+
+	if watch == nil {
+		if assign {
+			return false, fmt.Errorf("watch %s not found", watchName)
+		}
+		return true, nil
+	}
+
+	assigned := lo.Contains(watch.AssignedPolicies, policy)
+	if assigned == assign {
+		return true, nil
+	}
+
+	// Drop an assignment of the policy with another type as well, to not
+	// assign the same policy twice.
+	assignedPolicies := lo.Reject(watch.AssignedPolicies, func(p WatchAssignedPolicyAPIModel, _ int) bool {
+		return p.Name == policy.Name
+	})
+	if assign {
+		assignedPolicies = append(assignedPolicies, policy)
+	}
+
+	// Only the assigned policies are replaced, the watch is not owned by this
+	// resource.
+	body, err := raw.merge(struct {
+		AssignedPolicies []WatchAssignedPolicyAPIModel `json:"assigned_policies"`
+	}{assignedPolicies})
+	if err != nil {
+		return false, err
+	}
+
+	request, err := getRestyRequest(client, projectKey)
+	if err != nil {
+		return false, err
+	}
+
+	response, err := request.
+		SetPathParam("name", watchName).
+		SetBody(body).
+		Put(WatchEndpoint)
+	if err != nil {
+		return false, err
+	}
+
+	if response.StatusCode() == http.StatusConflict {
+		return false, nil
+	}
+
+	if response.IsError() {
+		return false, fmt.Errorf("%s", response.String())
+	}
+
+	watch, _, err = getWatch(client, projectKey, watchName)
+	if err != nil {
+		return false, err
+	}
+
+	if watch == nil {
+		return !assign, nil
+	}
+
+	return lo.Contains(watch.AssignedPolicies, policy) == assign, nil
+}
+
+// assignWatchPolicy assigns or unassigns the policy with a read-modify-write of
+// the watch. As the watch API has no concurrency control, the watch is read
+// back after each update and the update is retried when a concurrent update
+// overwrote it.
+func assignWatchPolicy(ctx context.Context, client *resty.Client, projectKey, watchName string, policy WatchAssignedPolicyAPIModel, assign bool) error {
+	for attempt := 0; attempt <= watchPolicyAssignmentMaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(attempt) * watchPolicyAssignmentRetryInterval):
+			}
+		}
+
+		done, err := tryAssignWatchPolicy(client, projectKey, watchName, policy, assign)
+		if err != nil {
+			return err
+		}
+
+		if done {
+			return nil
+		}
+	}
+
+	action := "assign policy %s to"
+	if !assign {
+		action = "unassign policy %s from"
+	}
+	return fmt.Errorf("failed to "+action+" watch %s after %d retries, the watch is modified concurrently", policy.Name, watchName, watchPolicyAssignmentMaxRetries)
+}
+
+func (r *WatchPolicyAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	go util.SendUsageResourceCreate(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var plan WatchPolicyAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := assignWatchPolicy(ctx, r.ProviderData.Client, plan.ProjectKey.ValueString(), plan.WatchName.ValueString(), plan.toAPIModel(), true)
+	if err != nil {
+		utilfw.UnableToCreateResourceError(resp, err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s:%s", plan.WatchName.ValueString(), plan.PolicyName.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *WatchPolicyAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	go util.SendUsageResourceRead(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state WatchPolicyAssignmentResourceModel
+
+	// Read Terraform state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	watch, _, err := getWatch(r.ProviderData.Client, state.ProjectKey.ValueString(), state.WatchName.ValueString())
+	if err != nil {
+		utilfw.UnableToRefreshResourceError(resp, err.Error())
+		return
+	}
+
+	if watch == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	policy, found := lo.Find(watch.AssignedPolicies, func(p WatchAssignedPolicyAPIModel) bool {
+		return p.Name == state.PolicyName.ValueString()
+	})
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(fmt.Sprintf("%s:%s", state.WatchName.ValueString(), state.PolicyName.ValueString()))
+	state.PolicyType = types.StringValue(policy.Type)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *WatchPolicyAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, the assignment is never updated in place.
+	resp.Diagnostics.AddError(
+		"Unable to update resource",
+		"xray_watch_policy_assignment does not support update in place, all attributes require replacement.",
+	)
+}
+
+func (r *WatchPolicyAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	go util.SendUsageResourceDelete(ctx, r.ProviderData.Client.R(), r.ProviderData.ProductId, r.TypeName)
+
+	var state WatchPolicyAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := assignWatchPolicy(ctx, r.ProviderData.Client, state.ProjectKey.ValueString(), state.WatchName.ValueString(), state.toAPIModel(), false)
+	if err != nil {
+		utilfw.UnableToDeleteResourceError(resp, err.Error())
+		return
+	}
+
+	// If the logic reaches here, it implicitly succeeded and will remove
+	// the resource from state if there are no other errors.
+}
+
+// ModifyPlan looks up the policy, to report a policy which doesn't exist or
+// doesn't match the type when planning instead of failing the apply. The policy
+// of an unchanged assignment is not looked up, and the policies are looked up
+// once per provider instance.
+func (r *WatchPolicyAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Resource is being destroyed, or the provider is not configured yet.
+	if req.Plan.Raw.IsNull() || r.ProviderData.Client == nil {
+		return
+	}
+
+	var plan WatchPolicyAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ProjectKey.IsUnknown() || plan.PolicyName.IsUnknown() || plan.PolicyType.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state WatchPolicyAssignmentResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if state.ProjectKey.Equal(plan.ProjectKey) && state.toAPIModel() == plan.toAPIModel() {
+			return
+		}
+	}
+
+	checkReferences(&resp.Diagnostics, false, func() ([]invalidReference, error) {
+		detail, err := checkPolicyReference(r.ProviderData.Client, referenceCacheFor(r.ProviderData.Client), plan.ProjectKey.ValueString(), plan.PolicyName.ValueString(), plan.PolicyType.ValueString())
+		if err != nil || detail == "" {
+			return nil, err
+		}
+
+		return []invalidReference{{path: path.Root("policy_name"), detail: detail}}, nil
+	})
+}
+
+// ImportState imports the resource into the Terraform state. The ID is in the
+// format `watch_name:policy_name`, optionally followed by `:project_key`.
+func (r *WatchPolicyAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 3)
+
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: watch_name:policy_name or watch_name:policy_name:project_key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("watch_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_name"), parts[1])...)
+
+	if len(parts) == 3 && parts[2] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), parts[2])...)
+	}
+}
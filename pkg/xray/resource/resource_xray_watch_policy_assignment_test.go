@@ -0,0 +1,235 @@
+package xray_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/jfrog/terraform-provider-shared/testutil"
+	"github.com/jfrog/terraform-provider-shared/util"
+	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+	xray "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+)
+
+const watchPolicyAssignmentTemplate = `resource "xray_security_policy" "security" {
+  name        = "{{ .policy_name_0 }}"
+  description = "Security policy description"
+  type        = "security"
+  rule {
+    name     = "rule-name-severity"
+    priority = 1
+    criteria {
+      min_severity = "High"
+    }
+    actions {
+      block_download {
+        unscanned = true
+        active    = true
+      }
+      fail_build = true
+    }
+  }
+}
+
+resource "xray_license_policy" "license" {
+  name        = "{{ .policy_name_1 }}"
+  description = "License policy description"
+  type        = "license"
+  rule {
+    name     = "License_rule"
+    priority = 1
+    criteria {
+      allowed_licenses = ["Apache-1.0", "Apache-2.0"]
+      allow_unknown    = false
+    }
+    actions {
+      block_download {
+        unscanned = true
+        active    = true
+      }
+      fail_build = true
+    }
+  }
+}
+
+resource "xray_watch" "{{ .resource_name }}" {
+  name                     = "{{ .watch_name }}"
+  description              = "watch created by xray acceptance tests"
+  active                   = true
+  ignore_external_policies = true
+
+  watch_resource {
+    type = "all-repos"
+  }
+
+  assigned_policy {
+    name = xray_security_policy.security.name
+    type = "security"
+  }
+}
+
+resource "xray_watch_policy_assignment" "{{ .resource_name }}" {
+  watch_name  = xray_watch.{{ .resource_name }}.name
+  policy_name = xray_license_policy.license.name
+  policy_type = "license"
+}`
+
+func TestAccWatchPolicyAssignment_full(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("watch-policy-assignment-", "xray_watch_policy_assignment")
+	watchFqrn := "xray_watch." + resourceName
+
+	testData := map[string]string{
+		"resource_name": resourceName,
+		"watch_name":    fmt.Sprintf("xray-watch-%d", testutil.RandomInt()),
+		"policy_name_0": fmt.Sprintf("xray-policy-0%d", testutil.RandomInt()),
+		"policy_name_1": fmt.Sprintf("xray-policy-1%d", testutil.RandomInt()),
+	}
+	config := util.ExecuteTemplate(fqrn, watchPolicyAssignmentTemplate, testData)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		CheckDestroy: acctest.VerifyDeleted(watchFqrn, "name", func(id string, request *resty.Request) (*resty.Response, error) {
+			acctest.CheckPolicyDeleted(testData["policy_name_0"], t, request)
+			acctest.CheckPolicyDeleted(testData["policy_name_1"], t, request)
+			return testCheckWatch(id, request)
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fqrn, "id", fmt.Sprintf("%s:%s", testData["watch_name"], testData["policy_name_1"])),
+					resource.TestCheckResourceAttr(fqrn, "watch_name", testData["watch_name"]),
+					resource.TestCheckResourceAttr(fqrn, "policy_name", testData["policy_name_1"]),
+					resource.TestCheckResourceAttr(fqrn, "policy_type", "license"),
+					resource.TestCheckResourceAttr(watchFqrn, "ignore_external_policies", "true"),
+					resource.TestCheckResourceAttr(watchFqrn, "assigned_policy.#", "1"),
+					resource.TestCheckResourceAttr(watchFqrn, "assigned_policy.0.name", testData["policy_name_0"]),
+				),
+			},
+			{
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:                         fqrn,
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s:%s", testData["watch_name"], testData["policy_name_1"]),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "id",
+			},
+		},
+	})
+}
+
+func TestAccWatchPolicyAssignment_invalid_policy_type(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("watch-policy-assignment-", "xray_watch_policy_assignment")
+
+	config := util.ExecuteTemplate(fqrn, `
+		resource "xray_watch_policy_assignment" "{{ .name }}" {
+		  watch_name  = "fake-watch"
+		  policy_name = "fake-policy"
+		  policy_type = "invalid"
+		}
+	`, map[string]string{
+		"name": resourceName,
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`.*Attribute policy_type value must be one of.*`),
+			},
+		},
+	})
+}
+
+// fakeAssignmentWatchXray serves a watch which loses the first lostPuts
+// updates, as if they were overwritten by a concurrent update.
+type fakeAssignmentWatchXray struct {
+	mu               sync.Mutex
+	lostPuts         int
+	puts             int
+	assignedPolicies []xray.WatchAssignedPolicyAPIModel
+}
+
+func (x *fakeAssignmentWatchXray) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/xray/api/v2/watches/watch":
+		json.NewEncoder(w).Encode(xray.WatchAPIModel{
+			GeneralData:      xray.WatchGeneralDataAPIModel{Name: "watch", Active: true},
+			AssignedPolicies: x.assignedPolicies,
+		})
+	case r.Method == http.MethodPut && r.URL.Path == "/xray/api/v2/watches/watch":
+		x.puts++
+		if x.puts <= x.lostPuts {
+			return
+		}
+
+		var body xray.WatchAPIModel
+		json.NewDecoder(r.Body).Decode(&body)
+		x.assignedPolicies = body.AssignedPolicies
+	}
+}
+
+func TestWatchPolicyAssignment_lostUpdateRetried(t *testing.T) {
+	for _, lostPuts := range []int{0, 1} {
+		t.Run(fmt.Sprintf("lost_%d", lostPuts), func(t *testing.T) {
+			ctx := context.Background()
+
+			fakeXray := &fakeAssignmentWatchXray{lostPuts: lostPuts}
+			server := httptest.NewServer(fakeXray)
+			defer server.Close()
+
+			r := xray.NewWatchPolicyAssignmentResource()
+			r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+				ProviderData: util.ProviderMetadata{Client: resty.New().SetBaseURL(server.URL)},
+			}, &fwresource.ConfigureResponse{})
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			value, err := tftypes.ValueFromJSON([]byte(`{"watch_name": "watch", "policy_name": "policy", "policy_type": "security"}`), schemaResp.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				t.Fatalf("failed to build the assignment value: %s", err)
+			}
+
+			resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: value}}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+
+			if fakeXray.puts != lostPuts+1 {
+				t.Errorf("expected %d updates of the watch, got %d", lostPuts+1, fakeXray.puts)
+			}
+
+			expected := []xray.WatchAssignedPolicyAPIModel{{Name: "policy", Type: "security"}}
+			if !reflect.DeepEqual(fakeXray.assignedPolicies, expected) {
+				t.Errorf("expected assigned policies %v, got %v", expected, fakeXray.assignedPolicies)
+			}
+		})
+	}
+}
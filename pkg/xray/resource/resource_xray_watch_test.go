@@ -1,18 +1,32 @@
 package xray_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/jfrog/terraform-provider-shared/client"
 	"github.com/jfrog/terraform-provider-shared/testutil"
 	"github.com/jfrog/terraform-provider-shared/util"
 	"github.com/jfrog/terraform-provider-shared/util/sdk"
 	"github.com/jfrog/terraform-provider-xray/v3/pkg/acctest"
+	xray "github.com/jfrog/terraform-provider-xray/v3/pkg/xray/resource"
+	"github.com/samber/lo"
 )
 
 var testDataWatch = map[string]string{
@@ -2344,3 +2358,163 @@ func testCheckWatch(id string, request *resty.Request) (*resty.Response, error)
 func RandomProjectName() string {
 	return fmt.Sprintf("testproj%d", rand.Intn(100))
 }
+
+func TestAccWatch_unknownPolicy(t *testing.T) {
+	_, fqrn, resourceName := testutil.MkNames("watch-", "xray_watch")
+
+	config := util.ExecuteTemplate(fqrn, `
+		resource "xray_watch" "{{ .resource_name }}" {
+		  name        = "{{ .watch_name }}"
+		  description = "watch created by xray acceptance tests"
+		  active      = true
+
+		  watch_resource {
+		    type = "all-repos"
+		  }
+
+		  assigned_policy {
+		    name = "{{ .policy_name }}"
+		    type = "security"
+		  }
+		}
+	`, map[string]string{
+		"resource_name": resourceName,
+		"watch_name":    fmt.Sprintf("xray-watch-%d", testutil.RandomInt()),
+		"policy_name":   fmt.Sprintf("unknown-policy-%d", testutil.RandomInt()),
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		CheckDestroy:             acctest.VerifyDeleted(fqrn, "name", testCheckWatch),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile(`(?s).*Invalid reference.*Policy 'unknown-policy-\d+' does not exist.*`),
+			},
+		},
+	})
+}
+
+func TestWatchResourceModel_FromAPIModel_unknownFilterType(t *testing.T) {
+	watch := xray.WatchAPIModel{
+		GeneralData: xray.WatchGeneralDataAPIModel{
+			Name:   "watch-with-unknown-filter",
+			Active: true,
+		},
+		ProjectResources: xray.WatchProjectResourcesAPIModel{
+			Resources: []xray.WatchProjectResourceAPIModel{
+				{
+					Type: "all-repos",
+					Filters: []xray.WatchFilterAPIModel{
+						{Type: "regex", Value: json.RawMessage(`".*"`)},
+						{Type: "unknown-filter", Value: json.RawMessage(`{"foo": "bar"}`)},
+					},
+				},
+			},
+		},
+	}
+
+	var model xray.WatchResourceModel
+	diags := model.FromAPIModel(context.Background(), watch)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning, got %v", diags)
+	}
+
+	resources := model.WatchResource.Elements()
+	if len(resources) != 1 {
+		t.Fatalf("expected 1 watch resource, got %d", len(resources))
+	}
+	filters := resources[0].(types.Object).Attributes()["filter"].(types.Set).Elements()
+	if len(filters) != 1 {
+		t.Errorf("expected the known filter only, got %d filters", len(filters))
+	}
+}
+
+func TestWatch_planLooksUpChangedPolicies(t *testing.T) {
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	lookups := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+
+		name, found := strings.CutPrefix(r.URL.Path, "/xray/api/v2/policies/")
+		if r.Method != http.MethodGet || !found {
+			return
+		}
+
+		lookups[name]++
+		if name == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "policy not found"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(xray.PolicyAPIModel{Name: name, Type: "security"})
+	}))
+	defer server.Close()
+
+	restyClient := resty.New().SetBaseURL(server.URL)
+	var schemaResp fwresource.SchemaResponse
+	xray.NewWatchResource().Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	value := func(policies ...string) tftypes.Value {
+		assignedPolicies := lo.Map(policies, func(name string, _ int) string {
+			return fmt.Sprintf(`{"name": "%s", "type": "security"}`, name)
+		})
+		v, err := tftypes.ValueFromJSON([]byte(fmt.Sprintf(`{"name": "watch", "assigned_policy": [%s]}`, strings.Join(assignedPolicies, ","))), schemaResp.Schema.Type().TerraformType(ctx))
+		if err != nil {
+			t.Fatalf("failed to build the watch value: %s", err)
+		}
+		return v
+	}
+
+	modifyPlan := func(state tftypes.Value, plan tftypes.Value) diag.Diagnostics {
+		// Each plan uses a new resource of the same provider instance.
+		r := xray.NewWatchResource()
+		r.(fwresource.ResourceWithConfigure).Configure(ctx, fwresource.ConfigureRequest{
+			ProviderData: util.ProviderMetadata{Client: restyClient},
+		}, &fwresource.ConfigureResponse{})
+
+		req := fwresource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan},
+			Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+			State:  tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+		}
+		resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+		r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, req, &resp)
+		return resp.Diagnostics
+	}
+
+	noState := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	diags := modifyPlan(noState, value("policy-1", "policy-2"))
+	if diags.WarningsCount() != 0 || diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The policies found are cached, only the new policy is looked up.
+	diags = modifyPlan(noState, value("policy-1", "policy-2", "policy-3"))
+	if diags.WarningsCount() != 0 || diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	// The policies in the state are not looked up, and missing policies are
+	// looked up on every plan.
+	for range 2 {
+		diags = modifyPlan(value("policy-4"), value("policy-4", "missing"))
+		if diags.WarningsCount() != 1 || diags.HasError() {
+			t.Fatalf("expected a warning for the missing policy, got: %v", diags)
+		}
+	}
+
+	expected := map[string]int{"policy-1": 1, "policy-2": 1, "policy-3": 1, "missing": 2}
+	if !reflect.DeepEqual(lookups, expected) {
+		t.Errorf("expected lookups %v, got %v", expected, lookups)
+	}
+}
@@ -3,6 +3,8 @@ package xray
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -13,6 +15,10 @@ import (
 	validatorfw_string "github.com/jfrog/terraform-provider-shared/validator/fw/string"
 )
 
+// exposureCategories are the categories of the JFrog Advanced Security
+// exposures scanners.
+var exposureCategories = []string{"secrets", "services", "applications", "iac"}
+
 func getRestyRequest(client *resty.Client, projectKey string) (*resty.Request, error) {
 	if client == nil {
 		return nil, fmt.Errorf("client is nil")
@@ -80,3 +86,63 @@ func (v IsRFC3339TimeValidator) ValidateString(ctx context.Context, req validato
 func IsRFC3339Time() IsRFC3339TimeValidator {
 	return IsRFC3339TimeValidator{}
 }
+
+var daysDurationRegex = regexp.MustCompile(`^(\d+)d$`)
+
+// parseDuration parses a duration string as time.ParseDuration does, with the
+// addition of the 'd' unit for whole days, e.g. "30d".
+func parseDuration(s string) (time.Duration, error) {
+	if matches := daysDurationRegex.FindStringSubmatch(s); len(matches) > 1 {
+		days, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+
+	return time.ParseDuration(s)
+}
+
+type IsDurationValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v IsDurationValidator) Description(ctx context.Context) string {
+	return "string must be a positive duration, e.g. 30d or 720h"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v IsDurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "string must be a positive duration, e.g. `30d` or `720h`"
+}
+
+// Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v IsDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// If the value is unknown or null, there is nothing to validate.
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	durationString := req.ConfigValue.ValueString()
+
+	duration, err := parseDuration(durationString)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration Format",
+			fmt.Sprintf("Value must be a duration in days (e.g. 30d) or a Go duration (e.g. 720h), got: %s: %+v", durationString, err),
+		)
+		return
+	}
+
+	if duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Value must be a positive duration, got: %s", durationString),
+		)
+	}
+}
+
+func IsDuration() IsDurationValidator {
+	return IsDurationValidator{}
+}